@@ -0,0 +1,62 @@
+package main
+
+import (
+	"strconv"
+)
+
+func chronalcalibration(rep *Report) error {
+	lines, err := PuzzleInputLines(1)
+	if err != nil {
+		return err
+	}
+
+	changes, err := parseFreqChanges(lines)
+	if err != nil {
+		return err
+	}
+
+	rep.Answer(1, CalibrateFrequency(changes))
+	rep.Answer(2, FindRepeatFrequency(changes))
+	return nil
+}
+
+func parseFreqChanges(lines []string) ([]int, error) {
+	var v []int
+	for _, s := range lines {
+		d, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, d)
+	}
+	return v, nil
+}
+
+// CalibrateFrequency returns the sum of changes.
+func CalibrateFrequency(changes []int) int {
+	freq := 0
+	for _, d := range changes {
+		freq += d
+	}
+	return freq
+}
+
+// FindRepeatFrequency returns the first frequency reached twice
+// when changes are applied repeatedly.
+func FindRepeatFrequency(changes []int) int {
+	if len(changes) == 0 {
+		return 0
+	}
+
+	freq := 0
+	seen := map[int]struct{}{freq: {}}
+	for {
+		for _, d := range changes {
+			freq += d
+			if _, ok := seen[freq]; ok {
+				return freq
+			}
+			seen[freq] = struct{}{}
+		}
+	}
+}
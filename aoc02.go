@@ -1,6 +1,19 @@
 package main
 
-import "strings"
+import (
+	"strings"
+)
+
+func inventorymanagement(rep *Report) error {
+	ids, err := PuzzleInputLines(2)
+	if err != nil {
+		return err
+	}
+
+	rep.Answer(1, BoxIDListChecksum(ids))
+	rep.Answer(2, strings.Join(BoxIDListSimilar(ids), " "))
+	return nil
+}
 
 func BoxIDListChecksum(ids []string) int {
 	c2, c3 := 0, 0
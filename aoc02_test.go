@@ -1,268 +1,33 @@
 package main
 
-import (
-	"strings"
-	"testing"
-)
+import "testing"
 
 func TestAoC02(t *testing.T) {
-	v := BoxIDListChecksum(input02)
-	t.Log(v)
+	got1 := BoxIDListChecksum([]string{
+		"abcdef",
+		"bababc",
+		"abbcde",
+		"abcccd",
+		"aabcdd",
+		"abcdee",
+		"ababab",
+	})
+	want1 := 12
+	if got1 != want1 {
+		t.Errorf("BoxIDListChecksum got %d; want %d", got1, want1)
+	}
 
-	l := BoxIDListSimilar(input02)
-	for _, v := range l {
-		t.Log(v)
+	got2 := BoxIDListSimilar([]string{
+		"abcde",
+		"fghij",
+		"klmno",
+		"pqrst",
+		"fguij",
+		"axcye",
+		"wvxyz",
+	})
+	want2 := "fgij"
+	if len(got2) != 1 || got2[0] != want2 {
+		t.Errorf("BoxIDListSimilar got %q; want [%q]", got2, want2)
 	}
 }
-
-var input02 = strings.Fields(`ovfclbidieyujztrswxmckgnaw
-pmfqlbimheyujztrswxmckgnap
-ovfqlbidhetujztrswxmcfgnas
-gvfqebddheyujztrswxmckgnap
-ovfqlbidheyejztrswxqekgnap
-ovzqlbiqheyujztsswxmckgnap
-oofqlbidhoyujztoswxmckgnap
-ovfqlbicqeyujztrswxmckgncp
-ovfelbidheyujltrswxmcwgnap
-ovfqlbidheyujzerswxmchgnaf
-bvfqlbidheyxjztnswxmckgnap
-ovfqlbidheyugztrswamnkgnap
-ovfqxbidheyujrtrswxmckgbap
-ovfqlbidheyujztrdwxqckgjap
-ovfqebiqheyujztrscxmckgnap
-avfqlbidheyvjztkswxmckgnap
-ovfqlbidheyujktrswxvskgnap
-ovfqlbidheeujztrswrmckgnae
-ovaqlbidheydjztrswxmchgnap
-ovfqlbodweyujztpswxmckgnap
-xvfqlbidhmyujztrswxmykgnap
-ovfqlnidheyujztxswumckgnap
-ovfqlbidhexujztrswxyckgeap
-ovfqlkidhekubztrswxmckgnap
-ovfqlbidheysjzkrsxxmckgnap
-oxfqebidheyujzprswxmckgnap
-ovfqlbidhetujztrswmmckghap
-ovfclbidhuyujztrswrmckgnap
-ovfqlbijhdyujztrswxmcvgnap
-ovfqlkidhyyujztrswxvckgnap
-ovfqlbiehlyujztrswxhckgnap
-ovfqlbidheyxjjtrsdxmckgnap
-jvfqlbidheyujztrvwxmckcnap
-ovfvlbidheyujzhrswxmckgnzp
-ovfqnbidhuyujztrswfmckgnap
-ovfrlbidheyujztpswxmckgnat
-ovfqpbidheyujztrywxmcngnap
-ovfqlbidheyumrtrswpmckgnap
-ovfqlbidhoyzjztrswxmckgkap
-ovfqlbibheyuhztrswxmskgnap
-ovfqlbidheybjzfrswxkckgnap
-ovfqnbinheyujztrawxmckgnap
-ovfqlbidheyujztryxxmckgnao
-ovfqzbidheyujztrsuxmckgnpp
-ovfqlbidherujztrswxmckgjsp
-ovfqlbidheyujhtrywxmckgtap
-oofmlbidheyujftrswxmckgnap
-ovfqlbidhhyujztrawxmckgnbp
-ovfqlbidheyujztrswxeckmnae
-lvfqlbidheyujztrswxzckvnap
-ovfqlbidheyujztrswxmckqnfr
-offqlbidheyrjztrswxmwkgnap
-ovnqlbidzeyujztmswxmckgnap
-ovfxlbxdheyujztrawxmckgnap
-ovfqmbidheyujztrsaxwckgnap
-ovfqlbidhryzjztrswxmckgcap
-offqlbidheyujnthswxmckgnap
-ogmqlbimheyujztrswxmckgnap
-ovfqlbidheyulztkswxockgnap
-ovfqlbidheyujjtrswxmckypap
-ovfqibidheypjztrswxmskgnap
-ovfqlbwdhyyujztrswxmckgnnp
-ovfqlbidheyujztsvwxmckgkap
-odfqlbidoeyujztrswxjckgnap
-ovfqlbodpeyujztrswxmcggnap
-ovfqlbicheyujztrhwxmcagnap
-ovfqlbidheyuaztrgwxmckhnap
-ovfwlbidhyyujztrswtmckgnap
-ovfqlbidgzyujztrswxmckgcap
-ovnqlbcdheyujztrswxmckgnup
-ovfqlbieheyujrtrsdxmckgnap
-ovfqlbidkeyujztrswfmckgnqp
-ovfqlbidtekujztrswxsckgnap
-ovfklbedheyujztrscxmckgnap
-ovfqltivhnyujztrswxmckgnap
-ovfqlbidheyuvuyrswxmckgnap
-ovfqlbidheyjjrtrcwxmckgnap
-ojfqlbidheyujztrswxmckguvp
-ovfqlbidheiqjqtrswxmckgnap
-ivfqlfidheyujatrswxmckgnap
-cvfqlbidheyujgtrswxmckgnrp
-ovfclbidheeujztrswxmckgnaw
-ovfqlbhdheyujztrswvmcklnap
-ovfqcbidheyvjztaswxmckgnap
-ovgqlbijheyujztrswxvckgnap
-gvfqlbidheyujvtrswxmckgnaj
-ovfqlbidheyujztrdwxmcggnvp
-cvfqlbidheyujgtrswxmckqnap
-ovfqlbrdheyqjztrswxmckgnaj
-ovfqlbidheyujzjrswbmcrgnap
-ovfqlbvdheyujxtrswxvckgnap
-ovaqlbidheyujctrswxmbkgnap
-ovfqlbidheyujztgdwxvckgnap
-ovfqlbidhevujztrssxmwkgnap
-rvfqlbidheyujztrzwxmckhnap
-ovfqmbidheysjztrswxmikgnap
-ovfqlbidheiujztrsdxuckgnap
-ovfqlbidheyveztrswxmckgnah
-ovfqnbiaheytjztrswxmckgnap
-ovfqlbidnayujhtrswxmckgnap
-ovfqlbidheyujztnswxdckgnag
-ovfqlbidheyuyztrswxmzzgnap
-ovfqlbiohexujzthswxmckgnap
-lvfqlbidheyujztcswxxckgnap
-ovuqlbidhfxujztrswxmckgnap
-ovfqluidheyujotrswxmrkgnap
-ovfalbidheyujztrswxhckgngp
-ohjqlbidheyujztrswumckgnap
-ovfqxbidhecujztrspxmckgnap
-ovfqcbidheyusztrpwxmckgnap
-fvfwlbidheyujztrswxmcxgnap
-ovfqlbidhxyplztrswxmckgnap
-ovfqlbidheyujftrswxdckgrap
-ovfqlepdheyujztrswxmckgnjp
-ojjqlbidhuyujztrswxmckgnap
-ovfqlbwdheyujztrswxmcggeap
-ovfqlbidheyujltrscxkckgnap
-oifqibidheyujztrswxjckgnap
-ovfqlbigheyujztrswdmcqgnap
-ovfqlbieheyujztrswxzzkgnap
-ovfqlbidheyujztrswmmcgbnap
-ovfqlbidhnyujzerswxmkkgnap
-ovfqdbinheyujztrswxeckgnap
-oveqlbidheyujztrswhmckgnab
-ovfqkbytheyujztrswxmckgnap
-ovfqlbidheyujstsswxmcklnap
-ovfimbidheyujztrewxmckgnap
-ovfqebidhequjztrnwxmckgnap
-ovfqlbidheyukztrswxmckunwp
-oifqlbidheyuwztrswxmckgnao
-ovfqlbidweyufztrswxmckgtap
-evfqlbidheyujztrswxsckvnap
-svbqlbidheyujztrsaxmckgnap
-ovfqlbidheyaoztrswxmckjnap
-ovfqllidheyujztrswxmckynhp
-ohfqlbidheyujatrswtmckgnap
-omfjlfidheyujztrswxmckgnap
-xvfqlbidheyujutrswxvckgnap
-ovfqlbidheyukztsswxmzkgnap
-ovfqibidhehujztrswxeckgnap
-ovfqlbydheyuoztrswxmcygnap
-ovfqlbidheyufztrmwxmckvnap
-ovfqrbkdheyujztrswxmckgnaq
-ovfqlbigheyuyztrswxmckgzap
-ovfqlbidheyujztrsjxmcnnnap
-uvfqlbipheyujztrswxmckgnay
-ovfqlbddneyujbtrswxmckgnap
-tvfqlbidheyujztrswxpckgeap
-ovfqlbidheyuiztrhwxmckznap
-ovfqlbidheyujzteswxvckgvap
-avfqlbidheyijzlrswxmckgnap
-oqfqmbidheyujvtrswxmckgnap
-ovnqlbidneyujztrswxmckxnap
-ovfqlbidfeyujztrswxqckgncp
-ovfaybidheyujztrswxrckgnap
-ovfqlbidhemujzarnwxmckgnap
-ovfqlwidheyujctrsfxmckgnap
-ovtelbidheysjztrswxmckgnap
-ovfqlbidheyujztrswsmchunap
-pvfqlbidheyulztrswxmckynap
-ovfqlbzdhezujztfswxmckgnap
-ozfqibidheyujztrhwxmckgnap
-ovfqlbioheycjztmswxmckgnap
-ovfqleidheyujztoswxmckgnhp
-ovfqlcidhejujztrswnmckgnap
-eqfqlbidheyujztrswxmrkgnap
-ovfqlbitheywjztrmwxmckgnap
-ovfqlbidheyujptrswnmcggnap
-oofqlbidhjyujztrswxmcegnap
-ovfqlbidmeyujztrswxmcxgnxp
-ovjhlbidhefujztrswxmckgnap
-ovfqlbidkeyujzarswxmcugnap
-hvyqlridheyujztrswxmckgnap
-ovfqlbidheyujxtrswqmckgnpp
-ovfqlbidheyuiztrtsxmckgnap
-ovfqlbidqeyuuztrbwxmckgnap
-ovfqpbidheyujztrswxwekgnap
-ovfqltidheyujztrbwxmckgnab
-okfxluidheyujztrswxmckgnap
-ovfplbidheyujztrsaxmckgnax
-wvfqlbidheiujztrswxjckgnap
-ovfqlbidheyqjzlrsqxmckgnap
-ovfqlbadheyujztrsxxmckgnop
-ovfqliidheyujzerswvmckgnap
-ovlrlbidheyujztaswxmckgnap
-cvzqlbidheyujgtrswxmckqnap
-ovfqlbidheyujzuqswxmckgnnp
-ovfqlsjdheyujztrswxmcklnap
-ovrqlbidheyujztrssrmckgnap
-ovcqlbidheyujztrsmxmcognap
-ovcqlbidheyjjztrswxmckunap
-ovfilbrdhnyujztrswxmckgnap
-ovfqlbodheyujztrswxeckqnap
-ovfqlbidhuyujqtrswxdckgnap
-ovmqlbidheyujderswxmckgnap
-ovfylbidheyajzrrswxmckgnap
-ovfklbidhtyujzjrswxmckgnap
-rvfqlbidheyujztcswxcckgnap
-ovfnlyidheyuwztrswxmckgnap
-ovfqlbidhexujztrswxmpktnap
-ovfplbidheyfjztrswhmckgnap
-oyfqlbidmexujztrswxmckgnap
-mvfqlbidhcyujztrawxmckgnap
-ovfqlbidhqyujdtrswxmcbgnap
-ovfqjbidheybjrtrswxmckgnap
-ozfqlbidhbyujztrswxmckgpap
-okfqlbidheyujztrmwxmckhnap
-ovfqlbydheyujzrrswxnckgnap
-ovfqtbidheycjztrswxmckgnah
-avfqloidheyujztrswxyckgnap
-ovfqlbldteyujzyrswxmckgnap
-ovfqlbpdhedujztpswxmckgnap
-ovfqlbidheyujztrswxucrvnap
-ocnqlbidheyujztrswxmwkgnap
-ovfqlvidheyujztrswkmckgnlp
-evfqlbidheyujzmrswqmckgnap
-ovfqlbidhryujztrcwxmekgnap
-ovfqlbvdheyujztrzwxmcxgnap
-ovfqlridgeyujztrswxmkkgnap
-yvfqlbidheyujzthswzmckgnap
-ovfqlbidheyujmtrswxyukgnap
-ovfqlbidheqgjztrswdmckgnap
-dvfzlcidheyujztrswxmckgnap
-jvfqlbidheyujztrswxmczgnae
-ovfqlbzdheyujztrswxyckgnjp
-ovfqlbxdheyujatrswxmcqgnap
-ovftlbldheyujztrewxmckgnap
-owfqlbitheyujzyrswxmckgnap
-ovfqlbidheyujztrswxmchgtvp
-ovfqibidheyujzttswxmkkgnap
-ovkqlbodheyujztvswxmckgnap
-onfqlbbdheyujztrxwxmckgnap
-ovfqlbidyeysgztrswxmckgnap
-ovfqlbixherujztrswxmcngnap
-ovlqlbidfeyujztrswxgckgnap
-ovfqlbfdheyujztwswumckgnap
-ovfqlbidheeujztrswxmckgkah
-ovfqtbqdheyujztrswmmckgnap
-bbfqlbigheyujztrswxmckgnap
-ovfqljidheyujztrswxmwkgnip
-ovfqobidheyujztrsvxmmkgnap
-ovfqlbidheydjvtrswxmckanap
-ovfqlxidheyujztrswgmckgnep
-jvfqlbidhzyujztrswxmckgnay
-ovfqlbidhkyujztrswxmlkenap
-ovfqobidheyujztrswxmckjnaf
-ovfxlbidheyujztrswxmcbgnac
-ovfqcbidhtyujztrswxmckqnap
-ozfglbidheyujzvrswxmckgnap
-ovfqlbidheyujztoswxyckcnap
-`)
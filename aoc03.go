@@ -1,5 +1,31 @@
 package main
 
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+func fabricclaims(rep *Report) error {
+	lines, err := PuzzleInputLines(3)
+	if err != nil {
+		return err
+	}
+
+	var specs []CutSpec
+	for _, s := range lines {
+		cs, err := ParseCutSpec(s)
+		if err != nil {
+			return err
+		}
+		specs = append(specs, cs)
+	}
+
+	rep.Answer(1, FindCutSpecOverlap(specs, 2))
+	rep.Answer(2, FindCutSpecSingleID(specs))
+	return nil
+}
+
 type CutSpec struct {
 	ID int // claimant
 
@@ -10,6 +36,16 @@ type CutSpec struct {
 	Dy int
 }
 
+func ParseCutSpec(s string) (CutSpec, error) {
+	var cs CutSpec
+	_, err := fmt.Sscanf(s, "#%d @ %d,%d: %dx%d",
+		&cs.ID, &cs.X, &cs.Y, &cs.Dx, &cs.Dy)
+	if err != nil {
+		return CutSpec{}, errors.Wrapf(err, "scan %q", s)
+	}
+	return cs, nil
+}
+
 func FindCutSpecOverlap(specs []CutSpec, minovl int) int {
 	dx, dy := maxDim(specs)
 	f := newFabric(dx, dy)
@@ -1,26 +1,25 @@
 package main
 
-import (
-	"fmt"
-	"testing"
-)
+import "testing"
 
 func TestAoC03(t *testing.T) {
-	data := input03(t)
-	t.Log(FindCutSpecOverlap(data, 2))
-	t.Log(FindCutSpecSingleID(data))
-}
-
-func input03(t *testing.T) []CutSpec {
-	v := make([]CutSpec, 0, len(input03v))
-	for _, s := range input03v {
-		var cs CutSpec
-		_, err := fmt.Sscanf(s, "#%d @ %d,%d: %dx%d",
-			&cs.ID, &cs.X, &cs.Y, &cs.Dx, &cs.Dy)
+	var data []CutSpec
+	for _, s := range []string{
+		"#1 @ 1,3: 4x4",
+		"#2 @ 3,1: 4x4",
+		"#3 @ 5,5: 2x2",
+	} {
+		cs, err := ParseCutSpec(s)
 		if err != nil {
-			t.Fatalf("scan %q: %v", s, err)
+			t.Fatal(err)
 		}
-		v = append(v, cs)
+		data = append(data, cs)
+	}
+
+	if got, want := FindCutSpecOverlap(data, 2), 4; got != want {
+		t.Errorf("FindCutSpecOverlap got %d; want %d", got, want)
+	}
+	if got, want := FindCutSpecSingleID(data), 3; got != want {
+		t.Errorf("FindCutSpecSingleID got %d; want %d", got, want)
 	}
-	return v
 }
@@ -8,6 +8,25 @@ import (
 	"github.com/pkg/errors"
 )
 
+func guardrecords(rep *Report) error {
+	lines, err := PuzzleInputLines(4)
+	if err != nil {
+		return err
+	}
+
+	entries, err := ParseOnWatchLog(lines)
+	if err != nil {
+		return errors.Wrap(err, "parse on watch log")
+	}
+
+	id, m := FindMostSleepyGuard(entries)
+	rep.Answer(1, id*m)
+
+	id, m = FindMostSleptMinute(entries)
+	rep.Answer(2, id*m)
+	return nil
+}
+
 type WatchEntryType int
 
 const (
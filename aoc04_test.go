@@ -5,29 +5,6 @@ import (
 	"testing"
 )
 
-func TestAoC04(t *testing.T) {
-	data := input04(t)
-	id, m := FindMostSleepyGuard(data)
-	r := id * m
-	t.Logf("#1 id=%d minute=%d; %d×%d=%d", id, m, id, m, id*m)
-	// 19896 125362
-	if r <= 125362 {
-		t.Fatal("too low")
-	}
-
-	id, m = FindMostSleptMinute(data)
-	r = id * m
-	t.Logf("#2 id=%d minute=%d; %d×%d=%d", id, m, id, m, id*m)
-}
-
-func input04(t *testing.T) []WatchEntry {
-	r, err := ParseOnWatchLog(input04v)
-	if err != nil {
-		t.Fatalf("parse on watch log: %v", err)
-	}
-	return r
-}
-
 func TestAoC04Sample(t *testing.T) {
 	data, err := ParseOnWatchLog(sample04v)
 	if err != nil {
@@ -1,5 +1,22 @@
 package main
 
+import (
+	"strings"
+)
+
+func alchemicalpolymer(rep *Report) error {
+	lines, err := PuzzleInputLines(5)
+	if err != nil {
+		return err
+	}
+
+	polymer := strings.TrimSpace(strings.Join(lines, ""))
+
+	rep.Answer(1, len(DecomposePolymer(polymer)))
+	rep.Answer(2, len(CleanDecomposePolymer(polymer)))
+	return nil
+}
+
 func DecomposePolymer(polymer string) string {
 	return decomposePolymer([]byte(polymer))
 }
@@ -37,8 +54,6 @@ func decomposePolymer(p []byte) string {
 		p, q = q, p
 		q = q[:0]
 	}
-
-	panic("unreachable")
 }
 
 func CleanDecomposePolymer(polymer string) string {
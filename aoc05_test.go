@@ -15,10 +15,4 @@ func TestAoC05(t *testing.T) {
 	if got2 != want2 {
 		t.Fatalf("sample %v clean-decompose: got %v want %v", sample, got2, want2)
 	}
-
-	d := DecomposePolymer(input05)
-	t.Logf("decompose len(%d) -> len(%d)", len(input05), len(d))
-
-	cd := CleanDecomposePolymer(input05)
-	t.Logf("clean-decompose len(%d) -> len(%d)", len(input05), len(cd))
 }
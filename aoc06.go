@@ -2,20 +2,28 @@ package main
 
 import (
 	"fmt"
-	"log"
+
+	"github.com/pkg/errors"
 )
 
-func run06() {
+func chronalcoords(rep *Report) error {
+	lines, err := PuzzleInputLines(6)
+	if err != nil {
+		return err
+	}
+
 	var data []Point
-	for _, s := range input06v {
+	for _, s := range lines {
 		var x, y int
 		if _, err := fmt.Sscanf(s, "%d, %d", &x, &y); err != nil {
-			log.Fatalf("parse %q: %v", s, err)
+			return errors.Wrapf(err, "parse %q", s)
 		}
 		data = append(data, Pt(x, y))
 	}
 
-	FindNonInfArea(data)
+	rep.Answer(1, FindNonInfArea(data))
+	rep.Answer(2, FindAreaCloserThan(data, 10000))
+	return nil
 }
 
 type Point struct {
@@ -1,9 +1,6 @@
 package main
 
-import (
-	"fmt"
-	"testing"
-)
+import "testing"
 
 func TestAoC06(t *testing.T) {
 	sample := []Point{
@@ -26,16 +23,4 @@ func TestAoC06(t *testing.T) {
 	if got2 != want2 {
 		t.Fatalf("FindAreaCloserThan: got %d, want %d", got2, want2)
 	}
-
-	var data []Point
-	for _, s := range input06v {
-		var x, y int
-		if _, err := fmt.Sscanf(s, "%d, %d", &x, &y); err != nil {
-			t.Fatalf("parse %q: %v", s, err)
-		}
-		data = append(data, Pt(x, y))
-	}
-
-	t.Log(FindNonInfArea(data))
-	t.Log(FindAreaCloserThan(data, 10000))
 }
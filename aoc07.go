@@ -3,8 +3,33 @@ package main
 import (
 	"fmt"
 	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
+func assemblysteps(rep *Report) error {
+	lines, err := PuzzleInputLines(7)
+	if err != nil {
+		return err
+	}
+
+	var links []AssemblyLink
+	for _, s := range lines {
+		al, err := ParseAssemblyLink(s)
+		if err != nil {
+			return errors.Wrapf(err, "parse %q", s)
+		}
+		links = append(links, al)
+	}
+
+	rep.Answer(1, strings.Join(SortAssemblyInstr(links), ""))
+	rep.Answer(2, TimeAssembly(links, 5, func(s string) int {
+		return int(s[0] - 'A' + 61)
+	}))
+	return nil
+}
+
 type AssemblyLink struct {
 	Pred, Succ string
 }
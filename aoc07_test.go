@@ -30,15 +30,6 @@ Step F must be finished before step E can begin.`)
 	if got2 != want2 {
 		t.Logf("TimeAssembly: got %v, want %v", got2, want2)
 	}
-
-	data := parseAssemblyLinks(t, input07)
-	t.Log("SortAssemblyInstr:", strings.Join(SortAssemblyInstr(data), ""))
-	t.Log("TimeAssembly:", TimeAssembly(data, 5, func(s string) int {
-		if len(s) != 1 {
-			t.Fatal("invalid data")
-		}
-		return int(s[0] - 'A' + 61)
-	}))
 }
 
 func parseAssemblyLinks(t *testing.T, src string) []AssemblyLink {
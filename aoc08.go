@@ -1,6 +1,36 @@
 package main
 
-import "github.com/pkg/errors"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func memorytree(rep *Report) error {
+	lines, err := PuzzleInputLines(8)
+	if err != nil {
+		return err
+	}
+
+	var src []int
+	for _, s := range strings.Fields(strings.Join(lines, " ")) {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return err
+		}
+		src = append(src, n)
+	}
+
+	tree, err := ParseTree8(src)
+	if err != nil {
+		return err
+	}
+
+	rep.Answer(1, tree.SumMeta())
+	rep.Answer(2, tree.Value())
+	return nil
+}
 
 type Tree8 struct {
 	Child []*Tree8
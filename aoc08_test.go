@@ -20,10 +20,6 @@ func TestAoC08(t *testing.T) {
 	if got2 != want2 {
 		t.Fatalf("sample Value got %v, want %v", got2, want2)
 	}
-
-	tree := parse08(t, input08)
-	t.Logf("SumMeta: %d", tree.SumMeta())
-	t.Logf("Value: %d", tree.Value())
 }
 
 func parse08(t *testing.T, src string) *Tree8 {
@@ -1,5 +1,31 @@
 package main
 
+import (
+	"fmt"
+	"strings"
+)
+
+func marblemania(rep *Report) error {
+	lines, err := PuzzleInputLines(9)
+	if err != nil {
+		return err
+	}
+
+	var nplayers, nmarbles int
+	_, err = fmt.Sscanf(strings.Join(lines, ""), "%d players; last marble is worth %d points",
+		&nplayers, &nmarbles)
+	if err != nil {
+		return err
+	}
+
+	_, score := PlayMarbleRing(nplayers, nmarbles)
+	rep.Answer(1, score)
+
+	_, score = PlayMarbleRing(nplayers, nmarbles*100)
+	rep.Answer(2, score)
+	return nil
+}
+
 type mring struct {
 	left, right *mring
 
@@ -29,15 +29,4 @@ func TestAoC9(t *testing.T) {
 				x.nplayers, x.nmarbles, gotscore, x.wantscore)
 		}
 	}
-
-	// 452 players; last marble is worth 71250 points
-	nplayers, nmarbles := 452, 71250
-	winner, score := PlayMarbleRing(nplayers, nmarbles)
-	t.Logf("PlayMarbleRing 1: %d players, %d marbles: player %d wins with %d score",
-		nplayers, nmarbles, winner, score)
-
-	nmarbles *= 100
-	winner, score = PlayMarbleRing(nplayers, nmarbles)
-	t.Logf("PlayMarbleRing 2: %d players, %d marbles: player %d wins with %d score",
-		nplayers, nmarbles, winner, score)
 }
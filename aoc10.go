@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+func starsalign(rep *Report) error {
+	lines, err := PuzzleInputLines(10)
+	if err != nil {
+		return err
+	}
+
+	var rays []skyray
+	for _, s := range lines {
+		r, err := parseSkyray(s)
+		if err != nil {
+			return err
+		}
+		rays = append(rays, r)
+	}
+
+	n := alignSkyrays(rays, 1e6)
+
+	rep.Answer(1, skyShape(rays))
+	rep.Answer(2, n)
+	return nil
+}
+
+type skyray struct {
+	x, y   int
+	vx, vy int
+}
+
+func parseSkyray(s string) (skyray, error) {
+	var r skyray
+	//position=< 10703,  41994> velocity=<-1, -4>
+	_, err := fmt.Sscanf(s, "position=<%d,%d> velocity=<%d,%d>",
+		&r.x, &r.y, &r.vx, &r.vy)
+	if err != nil {
+		return skyray{}, errors.Wrapf(err, "parse %q", s)
+	}
+	return r, nil
+}
+
+// alignSkyrays advances rays until their extent is the smallest,
+// and returns the number of iterations needed.
+func alignSkyrays(rays []skyray, maxiter int) int {
+	adv := func(n int) {
+		for i := range rays {
+			r := &rays[i]
+			r.x += n * r.vx
+			r.y += n * r.vy
+		}
+	}
+
+	lastscore := skyShapeRad(rays)
+	for niter := 0; niter <= maxiter; niter++ {
+		adv(1)
+		score := skyShapeRad(rays)
+		if score > lastscore {
+			adv(-1)
+			return niter
+		}
+		lastscore = score
+	}
+	return -1
+}
+
+func skyShapeRad(rays []skyray) int64 {
+	var cx, cy int64
+	for _, r := range rays {
+		cx += int64(r.x)
+		cy += int64(r.y)
+	}
+	cx /= int64(len(rays))
+	cy /= int64(len(rays))
+
+	var maxr2 int64
+	for _, r := range rays {
+		dx := int64(r.x) - cx
+		dy := int64(r.y) - cy
+		r2 := dx*dx + dy*dy
+		if r2 > maxr2 {
+			maxr2 = r2
+		}
+	}
+	return maxr2
+}
+
+// skyShape renders rays with one line per row.
+func skyShape(rays []skyray) string {
+	ix := rays[0].x
+	iy := rays[0].y
+	ax, ay := ix, iy
+	for _, r := range rays {
+		if r.x < ix {
+			ix = r.x
+		}
+		if r.y < iy {
+			iy = r.y
+		}
+		if r.x > ax {
+			ax = r.x
+		}
+		if r.y > ay {
+			ay = r.y
+		}
+	}
+	dx := ax - ix + 1
+	dy := ay - iy + 1
+
+	buf := make([]byte, dx*dy)
+	for i := range buf {
+		buf[i] = '.'
+	}
+	for _, r := range rays {
+		x := r.x - ix
+		y := r.y - iy
+		ofs := x + y*dx
+		buf[ofs] = '#'
+	}
+
+	var out bytes.Buffer
+	for y := 0; y < dy; y++ {
+		ofs := y * dx
+		out.Write(buf[ofs : ofs+dx])
+		out.WriteByte('\n')
+	}
+	return out.String()
+}
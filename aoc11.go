@@ -1,5 +1,30 @@
 package main
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func chargefuelcells(rep *Report) error {
+	lines, err := PuzzleInputLines(11)
+	if err != nil {
+		return err
+	}
+
+	serial, err := strconv.Atoi(strings.TrimSpace(strings.Join(lines, "")))
+	if err != nil {
+		return err
+	}
+
+	x, y, _ := FindMaxFuelCellPowerN(300, serial, 3)
+	rep.Answer(1, fmt.Sprintf("%d,%d", x, y))
+
+	x, y, n, _ := FindMaxFuelCellPowerAny(300, serial)
+	rep.Answer(2, fmt.Sprintf("%d,%d,%d", x, y, n))
+	return nil
+}
+
 func FuelCellPower(x, y, serial int) int {
 	rackid := x + 10
 	pow := rackid*y + serial
@@ -67,8 +92,6 @@ func FindMaxFuelCellPowerN(dim, serial, n int) (left, top, power int) {
 			y++
 		}
 	}
-
-	panic("not reached")
 }
 
 func FindMaxFuelCellPowerAny(dim, serial int) (x, y, n, power int) {
@@ -47,9 +47,6 @@ func TestAoC11_1(t *testing.T) {
 				i, tt.serial, x, y, power, tt.x, tt.y, tt.power)
 		}
 	}
-
-	x, y, _ := FindMaxFuelCellPowerN(300, 1955, 3)
-	t.Logf("1: max power for 1955 at: %d,%d", x, y)
 }
 
 func TestAoC11_2(t *testing.T) {
@@ -73,7 +70,4 @@ func TestAoC11_2(t *testing.T) {
 				i, tt.serial, x, y, n, power, tt.x, tt.y, tt.n, tt.power)
 		}
 	}
-
-	x, y, n, _ := FindMaxFuelCellPowerAny(300, 1955)
-	t.Logf("2: max power for 1955 at: %d,%d,%d", x, y, n)
 }
@@ -8,6 +8,56 @@ import (
 	"github.com/pkg/errors"
 )
 
+func cavepots(rep *Report) error {
+	lines, err := PuzzleInputLines(12)
+	if err != nil {
+		return err
+	}
+
+	src := strings.Join(lines, "\n")
+
+	pots, err := NewCavePots(src)
+	if err != nil {
+		return errors.Wrap(err, "parse puzzle input")
+	}
+
+	for i := 0; i < 20; i++ {
+		pots.Step()
+	}
+
+	rep.Answer(1, pots.PlantSum())
+
+	pots, err = NewCavePots(src)
+	if err != nil {
+		return errors.Wrap(err, "parse puzzle input")
+	}
+
+	const simupto = 1500
+	const wantsim = 50000000000
+
+	var delta []int
+	lastsum := pots.PlantSum()
+	for i := 0; i < simupto; i++ {
+		pots.Step()
+		sum := pots.PlantSum()
+		delta = append(delta, sum-lastsum)
+		lastsum = sum
+	}
+
+	lastdelta := delta[len(delta)-1]
+
+	for i := len(delta) - 100; i < len(delta); i++ {
+		if delta[i] != lastdelta {
+			return errors.New("delta not repeating")
+		}
+	}
+
+	final := int64(lastsum) + int64(wantsim-simupto)*int64(lastdelta)
+
+	rep.Answer(2, final)
+	return nil
+}
+
 type CavePots struct {
 	zero int // offset of pot zero in slice
 
@@ -8,6 +8,35 @@ import (
 	"github.com/pkg/errors"
 )
 
+func minecartmadness(rep *Report) error {
+	lines, err := PuzzleInputLines(13)
+	if err != nil {
+		return err
+	}
+
+	m, err := ParseMinecartMap(lines)
+	if err != nil {
+		return err
+	}
+
+	showncrash := false
+	for {
+		m.Tick()
+
+		if len(m.crash) > 0 && !showncrash {
+			pt := m.crash[0]
+			rep.Answer(1, fmt.Sprintf("%d,%d", pt.X, pt.Y))
+			showncrash = true
+		}
+
+		if len(m.cart) == 1 {
+			cart := m.cart[0]
+			rep.Answer(2, fmt.Sprintf("%d,%d", cart.x, cart.y))
+			return nil
+		}
+	}
+}
+
 type mctile byte
 
 const (
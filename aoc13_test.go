@@ -62,27 +62,3 @@ func TestAoC13Sample2(t *testing.T) {
 		t.Errorf("last cart is at %d,%d; want %d,%d", got1.X, got1.Y, want1.X, want1.Y)
 	}
 }
-
-func TestAoC13(t *testing.T) {
-	m, err := ParseMinecartMap(input13v)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	showncrash := false
-	for i := 0; ; i++ {
-		m.Tick()
-
-		if len(m.crash) > 0 && !showncrash {
-			pt := m.crash[0]
-			t.Logf("tick %d: first crash at %d,%d", i, pt.X, pt.Y)
-			showncrash = true
-		}
-
-		if len(m.cart) == 1 {
-			cart := m.cart[0]
-			t.Logf("tick %d: last cart at %d,%d", i, cart.x, cart.y)
-			return
-		}
-	}
-}
@@ -2,25 +2,57 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/tajtiattila/aoc18/astar"
 )
 
+func goblinbattle(rep *Report) error {
+	lines, err := PuzzleInputLines(15)
+	if err != nil {
+		return err
+	}
+
+	layout := strings.Join(lines, "\n")
+
+	gf, err := ParseGoblinFight(layout)
+	if err != nil {
+		return err
+	}
+	rep.Answer(1, gf.FindOutcome())
+
+	for strength := 4; strength <= 200; strength++ {
+		gf, err := ParseGoblinFight(layout)
+		if err != nil {
+			return err
+		}
+
+		ec := gf.ElfCount()
+
+		gf.SetElfAttackStrength(strength)
+		oc := gf.FindOutcome()
+		if ec == gf.ElfCount() {
+			if verbose {
+				fmt.Fprintln(rep.Diag, "elf attack strength:", strength)
+			}
+			rep.Answer(2, oc)
+			return nil
+		}
+	}
+
+	return errors.New("elves can't win")
+}
+
 type GoblinFight struct {
 	dx, dy int
 
 	m []gftile
 
-	fm []int // map of path distances
-
-	// last flood info
-	lastf struct {
-		ix, ax int
-		iy, ay int
-	}
+	paths astar.Search[Point] // used by pickGoal and step
 
 	elf, goblin gfteam
 }
@@ -32,10 +64,6 @@ type gfteam struct {
 	attackStrength int
 }
 
-// fill value for GoblinFight.fm
-const gfmEmpty = 1e7
-const gfmBlock = 1e6
-
 func ParseGoblinFight(layout string) (*GoblinFight, error) {
 	src := strings.Split(strings.TrimSpace(layout), "\n")
 	dy := len(src)
@@ -52,7 +80,6 @@ func ParseGoblinFight(layout string) (*GoblinFight, error) {
 		dx: dx,
 		dy: dy,
 		m:  make([]gftile, dx*dy),
-		fm: make([]int, dx*dy),
 
 		elf: gfteam{
 			attackStrength: gfDefaultAttackStrength,
@@ -62,10 +89,6 @@ func ParseGoblinFight(layout string) (*GoblinFight, error) {
 		},
 	}
 
-	for i := range gf.fm {
-		gf.fm[i] = gfmEmpty
-	}
-
 	runetile := func(r rune) gftile {
 		var k, hp gftile
 		switch r {
@@ -232,46 +255,30 @@ func (gf *GoblinFight) pickGoal(p Point) (goal Point, goalDist int) {
 		panic("only units can pick goal")
 	}
 
-	var enemy []Point
-
-	enemyDist := gf.flood(p.X, p.Y, func(next Point) gflResult {
-		switch gf.tile(next.X, next.Y).Kind() {
-		case enemyKind:
-			enemy = append(enemy, next)
-			return gflStop
-		case gfSpace:
-			return gflContinue
-		default:
-			return gflBlock
+	nextToEnemy := func(q Point) bool {
+		var adjbuf [4]Point
+		for _, r := range gf.adj(q, adjbuf[:0]) {
+			if gf.m[gf.pofs(r)].Kind() == enemyKind {
+				return true
+			}
 		}
-	})
-
-	if len(enemy) == 0 {
-		return p, -1
+		return false
 	}
 
-	// distance of target position
-	goalDist = enemyDist - 1
-	if goalDist == 0 {
+	if nextToEnemy(p) {
 		// already next to an enemy
-		return p, goalDist
+		return p, 0
 	}
 
-	first := true
-	var adjbuf [4]Point
-	for _, enemyp := range enemy {
-		// check points next to an enemy
-		for _, q := range gf.adj(enemyp, adjbuf[:0]) {
-			if gf.fm[gf.pofs(q)] == goalDist {
-				if first || q.Y < goal.Y ||
-					(q.Y == goal.Y && q.X < goal.X) {
-					goal = q
-					first = false
-				}
-			}
-		}
+	s := gf.pathSearch(gf.isSpace)
+	s.Goal = nextToEnemy
+	s.AllPaths = false
+	res, err := s.AllGoals(context.Background(), p)
+	if err != nil {
+		return p, -1
 	}
-	return goal, goalDist
+
+	return readingFirst(res.Points), res.Cost
 }
 
 // step walks the unit at p one step along goal,
@@ -286,31 +293,57 @@ func (gf *GoblinFight) step(p, goal Point) Point {
 		panic("only units can step")
 	}
 
-	v := gf.flood(goal.X, goal.Y, func(w Point) gflResult {
-		switch {
-		case w == p:
-			return gflStop
-		case gf.m[gf.pofs(w)].Kind() != gfSpace:
-			return gflBlock
-		default:
-			return gflContinue
-		}
+	s := gf.pathSearch(func(q Point) bool {
+		return q == goal || gf.isSpace(q)
 	})
-	v--
-
-	var next Point
-	var adjbuf [4]Point
-	for _, next = range gf.adj(p, adjbuf[:0]) {
-		if gf.fm[gf.pofs(next)] == v {
-			break
-		}
+	s.Goal = func(q Point) bool { return q == goal }
+	s.AllPaths = true
+	res, err := s.AllGoals(context.Background(), p)
+	if err != nil {
+		return p
 	}
 
+	next := readingFirst(res.DAG.FirstSteps(goal))
+
 	gf.m[gf.pofs(p)] = gfSpace
 	gf.m[gf.pofs(next)] = unit
 	return next
 }
 
+// pathSearch returns a search for paths of
+// unit steps through points for which open is true.
+//
+// The search is reused so that its buffers are allocated only once.
+func (gf *GoblinFight) pathSearch(open func(q Point) bool) *astar.Search[Point] {
+	s := &gf.paths
+	s.Adjacent = func(q Point, dst []astar.Step[Point]) []astar.Step[Point] {
+		var adjbuf [4]Point
+		for _, r := range gf.adj(q, adjbuf[:0]) {
+			if open(r) {
+				dst = append(dst, astar.Step[Point]{Point: r, Cost: 1})
+			}
+		}
+		return dst
+	}
+	s.Index = gf.pofs
+	return s
+}
+
+func (gf *GoblinFight) isSpace(p Point) bool {
+	return gf.m[gf.pofs(p)].Kind() == gfSpace
+}
+
+// readingFirst returns the first of v in reading order.
+func readingFirst(v []Point) Point {
+	first := v[0]
+	for _, p := range v[1:] {
+		if p.Y < first.Y || (p.Y == first.Y && p.X < first.X) {
+			first = p
+		}
+	}
+	return first
+}
+
 func (gf *GoblinFight) attack(p Point, skip map[Point]struct{}) bool {
 	var enemyKind, attackStrength int
 	var enemyTeam *gfteam
@@ -358,75 +391,6 @@ func (gf *GoblinFight) attack(p Point, skip map[Point]struct{}) bool {
 	return true
 }
 
-func (gf *GoblinFight) flood(sx, sy int, floodf func(p Point) gflResult) int {
-	// clear last flood info
-	cofs := gf.ofs(gf.lastf.ix, gf.lastf.iy)
-	for y := gf.lastf.iy; y <= gf.lastf.ay; y++ {
-		ofs := cofs
-		cofs += gf.dx
-		for x := gf.lastf.ix; x <= gf.lastf.ax; x++ {
-			gf.fm[ofs] = gfmEmpty
-			ofs++
-		}
-	}
-
-	gf.lastf.ix = sx
-	gf.lastf.iy = sy
-	gf.lastf.ax = sx
-	gf.lastf.ay = sy
-
-	dist := 0
-	active := []Point{Pt(sx, sy)}
-	ofs := gf.ofs(sx, sy)
-	gf.fm[ofs] = dist
-	var nactive []Point
-	var adjbuf [4]Point
-	done := false
-	for !done && len(active) != 0 {
-		dist++
-		for _, q := range active {
-			for _, p := range gf.adj(q, adjbuf[:0]) {
-				ofs := gf.pofs(p)
-				if gf.fm[ofs] != gfmEmpty {
-					continue // processed
-				}
-
-				// extend flood bounding box
-				if p.X < gf.lastf.ix {
-					gf.lastf.ix = p.X
-				}
-				if p.Y < gf.lastf.iy {
-					gf.lastf.iy = p.Y
-				}
-				if p.X > gf.lastf.ax {
-					gf.lastf.ax = p.X
-				}
-				if p.Y > gf.lastf.ay {
-					gf.lastf.ay = p.Y
-				}
-
-				switch floodf(p) {
-
-				case gflBlock:
-					gf.fm[ofs] = gfmBlock
-
-				case gflContinue:
-					gf.fm[ofs] = dist
-					nactive = append(nactive, p)
-
-				case gflStop:
-					gf.fm[ofs] = dist
-					done = true
-				}
-			}
-		}
-
-		active, nactive = nactive, active[:0]
-	}
-
-	return dist
-}
-
 func (gf *GoblinFight) adj(p Point, res []Point) []Point {
 	if p.Y > 0 {
 		res = append(res, Pt(p.X, p.Y-1))
@@ -443,14 +407,6 @@ func (gf *GoblinFight) adj(p Point, res []Point) []Point {
 	return res
 }
 
-type gflResult int
-
-const (
-	gflBlock gflResult = iota // path blocked
-	gflContinue
-	gflStop // finish after processing tiles with the same distance
-)
-
 func (gf *GoblinFight) Dump(w io.Writer, withStat bool) {
 
 	var buf bytes.Buffer
@@ -492,19 +448,3 @@ func (gf *GoblinFight) Dump(w io.Writer, withStat bool) {
 		}
 	}
 }
-
-func (gf *GoblinFight) DumpFloodMap(w io.Writer) {
-	for i, v := range gf.fm {
-		switch v {
-		case gfmEmpty:
-			fmt.Fprint(w, "  .")
-		case gfmBlock:
-			fmt.Fprint(w, "  #")
-		default:
-			fmt.Fprintf(w, "%3d", v)
-		}
-		if i%gf.dx == gf.dx-1 {
-			fmt.Fprint(w, "\n")
-		}
-	}
-}
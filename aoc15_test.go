@@ -29,7 +29,7 @@ func TestAoC15PickGoal(t *testing.T) {
 		}
 		got, _ := gf.pickGoal(Pt(tt.sx, tt.sy))
 		buf := &bytes.Buffer{}
-		gf.DumpFloodMap(buf)
+		gf.Dump(buf, false)
 		t.Logf("map:\n%s", buf.String())
 		want := Pt(tt.ex, tt.ey)
 		if got != want {
@@ -60,7 +60,7 @@ func TestAoC15Step(t *testing.T) {
 		}
 		got := gf.step(tt.unit, tt.goal)
 		buf := &bytes.Buffer{}
-		gf.DumpFloodMap(buf)
+		gf.Dump(buf, false)
 		t.Logf("map:\n%s", buf.String())
 		if got != tt.want {
 			t.Errorf("got %v; want %v", got, tt.want)
@@ -457,33 +457,6 @@ func TestAoC15Sim(t *testing.T) {
 	}
 }
 
-func TestAoC15Puzzle(t *testing.T) {
-	// puzzle
-	gf, err := ParseGoblinFight(input15)
-	if err != nil {
-		t.Fatal(err)
-	}
-	t.Logf("1st puzzle outcome: %d", gf.FindOutcome())
-
-	for strength := 4; strength <= 200; strength++ {
-		gf, err := ParseGoblinFight(input15)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		ec := gf.ElfCount()
-
-		gf.SetElfAttackStrength(strength)
-		oc := gf.FindOutcome()
-		if ec == gf.ElfCount() {
-			t.Logf("2nd puzzle outcome: %d (strength: %d)", oc, strength)
-			return
-		}
-	}
-
-	t.Fatal("failed")
-}
-
 func findOutcome(t *testing.T, gf *GoblinFight, label string, withStat bool) int {
 	step := 0
 	buf := &bytes.Buffer{}
@@ -1,28 +1,31 @@
 package main
 
 import (
-	"fmt"
 	"io"
-	"log"
-	"os"
 
 	"github.com/tajtiattila/aoc18/resrsrch"
 )
 
-func reservoirresearch() {
-	var w io.Writer
+func reservoirresearch(rep *Report) error {
+	var fw io.Writer
 
 	if verbose {
-		w = os.Stdout
+		fw = rep.Diag
 	}
 
-	gs, err := resrsrch.ParseGroundSlice(PuzzleInputLines(17))
+	lines, err := PuzzleInputLines(17)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	stat := gs.Flood(500, 0, w)
+	gs, err := resrsrch.ParseGroundSlice(lines)
+	if err != nil {
+		return err
+	}
+
+	stat := gs.Flood(500, 0, fw)
 
-	fmt.Println("17/1:", stat.Total())
-	fmt.Println("17/2:", stat.Static)
+	rep.Answer(1, stat.Total())
+	rep.Answer(2, stat.Static)
+	return nil
 }
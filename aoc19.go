@@ -2,102 +2,115 @@ package main
 
 import (
 	"fmt"
-	"log"
+	"io"
+	"math"
 
+	"github.com/pkg/errors"
 	"github.com/tajtiattila/aoc18/wristdev"
+	"github.com/tajtiattila/aoc18/wristdev/analysis"
+	"github.com/tajtiattila/aoc18/wristdev/profile"
 )
 
-func wristdev19() {
-	ipreg, prog := programInput(19)
-
-	const nreg = 6
-
-	regnames := make([]string, nreg)
-	for i := range regnames {
-		regnames[i] = fmt.Sprintf("r%d", i)
+func wristdev19(rep *Report) error {
+	p, err := programInput(19)
+	if err != nil {
+		return err
 	}
-	regnames[ipreg] = "ip"
+
+	arch, prog := p.Arch, p.Inst
 
 	if verbose {
-		fmt.Println("disassembly:")
-		for i, l := range prog {
-			fmt.Printf("%3d  %s\n", i, l.Fmt(regnames))
+		fmt.Fprintln(rep.Diag, "disassembly:")
+		analysis.Build(arch, prog).WritePseudo(rep.Diag)
+		fmt.Fprintln(rep.Diag)
+
+		// r0 is 0 or 1 for the two parts, other registers start at zero
+		entry := make([]analysis.Value, arch.NReg)
+		for i := range entry {
+			entry[i] = analysis.Const(0)
 		}
-		fmt.Println()
+		entry[0] = analysis.Range(0, 1)
+		fmt.Fprintln(rep.Diag, "values:")
+		analysis.AnalyzeValues(arch, prog, entry).WriteReport(rep.Diag)
+		fmt.Fprintln(rep.Diag)
 	}
 
-	arch := wristdev.ArchWithIP(nreg, ipreg)
 	state := arch.State()
 
-	runwristprog(state, prog)
+	if _, err := runwristprog(state, prog, rep.Diag); err != nil {
+		return err
+	}
 
-	fmt.Println("19/1:", state.R[0])
+	rep.Answer(1, state.R[0])
 	r0, r3 := aoc19sim(0)
 	if verbose {
-		fmt.Println(" Simulated:", r0, r3)
-		fmt.Println(" Divsum:", aoc19divSum(r3))
+		fmt.Fprintln(rep.Diag, " Simulated:", r0, r3)
+		fmt.Fprintln(rep.Diag, " Divsum:", aoc19divSum(r3))
 
 		showr3 := func(r0 int) {
 			state := arch.State(r0)
 			for *state.IP != 1 {
 				state.Step(prog)
 			}
-			fmt.Printf("r0=%d -> r3=%d\n", r0, state.R[3])
+			fmt.Fprintf(rep.Diag, "r0=%d -> r3=%d\n", r0, state.R[3])
 		}
 
 		showr3(0)
 		showr3(1)
 	}
 
-	r3 = aoc19init(1)
-	fmt.Println("19/2:", aoc19divSum(r3))
-}
+	// divisor sum loops are run as idioms
+	code := wristdev.Compile(arch, prog)
+	if verbose {
+		for _, id := range code.Idioms() {
+			fmt.Fprintf(rep.Diag, "idiom %q at %d..%d\n", id.Name, id.Start, id.End-1)
+		}
+	}
 
-func runwristprog(state *wristdev.State, prog []wristdev.Instruction) bool {
+	state = arch.State(1)
+	if code.Run(state, math.MaxInt64) {
+		return errors.New("program did not halt")
+	}
+
+	rep.Answer(2, state.R[0])
+	return nil
+}
 
-	w := verbosew
+func runwristprog(state *wristdev.State, prog []wristdev.Instruction, w io.Writer) (halted bool, err error) {
 
 	if !verbose {
-		state.RunProgram(prog, 1e9)
+		wristdev.Compile(state.Arch, prog).Run(state, 1e9)
 	} else {
-		fmt.Fprintln(w, "\n\n#ip", state.Arch.IP.Index)
-
-		seen := make(map[string]struct{})
-
-		for {
-			fmt.Fprintln(w, state, prog[*state.IP])
-			if !state.Step(prog) {
-				break
-			}
-
-			if _, ok := seen[state.String()]; ok {
-				log.Fatal("infinite loop")
-			} else {
-				seen[state.String()] = struct{}{}
-			}
+		prof := profile.New(state.Arch, prog)
+		state.Tracer = prof
+		state.RunProgram(prog, 1e9)
+		state.Tracer = nil
+		if err := prof.Profile().WriteText(w); err != nil {
+			return false, err
 		}
 	}
 
-	return !state.Step(prog)
+	return !state.Step(prog), nil
 }
 
-func programInput(n int) (ipreg int, prog []wristdev.Instruction) {
-	lines := PuzzleInputLines(n)
-
-	var ipspec string
-	ipspec, lines = lines[0], lines[1:]
-
-	_, err := fmt.Sscanf(ipspec, "#ip %d", &ipreg)
+// programInput parses the device program of puzzle n.
+func programInput(n int) (*wristdev.Program, error) {
+	r, err := OpenPuzzleInput(n)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
+	defer r.Close()
 
-	prog, err = wristdev.ParseProgram(lines)
+	p, err := wristdev.ParseSource(r)
 	if err != nil {
-		log.Fatal(err)
+		return nil, errors.Wrapf(err, "puzzle %d program", n)
+	}
+
+	if !p.Arch.IP.IsRegister {
+		return nil, errors.Errorf("puzzle %d program: #ip directive missing", n)
 	}
 
-	return ipreg, prog
+	return p, nil
 }
 
 /*
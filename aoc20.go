@@ -2,25 +2,28 @@ package main
 
 import (
 	"fmt"
-	"log"
 	"strings"
 
 	"github.com/tajtiattila/aoc18/gridregexp"
 )
 
-func facilitymaxdoors() {
-	src := strings.Join(PuzzleInputLines(20), "")
+func facilitymaxdoors(rep *Report) error {
+	lines, err := PuzzleInputLines(20)
+	if err != nil {
+		return err
+	}
+
+	src := strings.Join(lines, "")
 
 	gr, err := gridregexp.Parse(src)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
-	w := verbosew
-
 	m := gr.Map()
-	fmt.Fprintln(w, "extent:", m.Bounds())
+	fmt.Fprintln(rep.Diag, "extent:", m.Bounds())
 
-	fmt.Println("20/1:", m.MaxDoors())
-	fmt.Println("20/2:", m.FarRooms(1000))
+	rep.Answer(1, m.MaxDoors())
+	rep.Answer(2, m.FarRooms(1000))
+	return nil
 }
@@ -1,68 +1,51 @@
 package main
 
 import (
-	"context"
 	"fmt"
-	"log"
 
+	"github.com/pkg/errors"
 	"github.com/tajtiattila/aoc18/wristdev"
+	"github.com/tajtiattila/aoc18/wristdev/analysis"
 )
 
-func wristdev21() {
-	ipreg, prog := programInput(21)
+func wristdev21(rep *Report) error {
+	p, err := programInput(21)
+	if err != nil {
+		return err
+	}
 
-	const nreg = 6
+	prog := p.Inst
 
-	regnames := make([]string, nreg)
-	for i := range regnames {
-		regnames[i] = fmt.Sprintf("r%d", i)
+	if false {
+		fmt.Fprintln(rep.Diag, "disassembly:")
+		analysis.Build(p.Arch, prog).WritePseudo(rep.Diag)
+		fmt.Fprintln(rep.Diag)
 	}
-	regnames[ipreg] = "ip"
 
-	if false {
-		fmt.Println("disassembly:")
-		for i, l := range prog {
-			fmt.Printf("%3d  %s\n", i, l.Fmt(regnames))
+	if verbose {
+		// the branches depending on r0 are
+		// where a magic value may halt the program
+		v := analysis.AnalyzeValues(p.Arch, prog, nil)
+		for _, b := range v.BranchesOn(0) {
+			fmt.Fprintf(rep.Diag, "branch at %d depends on r0: %v\n", b.Addr, v.Result[b.Addr])
 		}
-		fmt.Println()
 	}
 
-	// verify simluation by comparing output with that of the program
-	fns := []aoc21simfunc{
-		aoc21simprog,
-		aoc21sim0,
-		aoc21sim1,
+	// verify simluations by comparing registers with
+	// those of the program at the comparison on line 28
+	const r0 = 0
+	dt := wristdev.DiffTest{
+		Prog:        prog,
+		At:          28,
+		Regs:        []int{0, 1, 2, 3, 4},
+		Checkpoints: 1000,
 	}
-
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	const (
-		simverifysteps = 1e6
-	)
-
-	ch := merge21(ctx, 0, fns)
-	step := 0
-	for ss := range ch {
-		step++
-		prog := ss[0]
-		for i := 1; i < len(ss); i++ {
-			if ss[i] != prog {
-				log.Printf("step %d: state #%d differ\n", step, i)
-				log.Fatal(ss)
-			}
-		}
-		if step == simverifysteps {
-			return
-		}
+	err = dt.Run(p.Arch.State(r0), aoc21sim0(r0), aoc21sim1(r0))
+	if err != nil {
+		return errors.Wrap(err, "simulation")
 	}
 
-	const (
-		c0 = 14906355 // 0xe373f3
-		c1 = 65899
-
-		m24 = 0xffffff
-	)
+	const c1 = 65899
 
 	if verbose {
 		div := 0
@@ -73,112 +56,32 @@ func wristdev21() {
 			}
 		}
 		if div != 0 {
-			fmt.Printf("%v is divisible by %v\n", c1, div)
+			fmt.Fprintf(rep.Diag, "%v is divisible by %v\n", c1, div)
 		} else {
-			fmt.Printf("%v is prime\n", c1)
+			fmt.Fprintf(rep.Diag, "%v is prime\n", c1)
 		}
 	}
 
-	r3m := make(map[uint32]struct{})
-	r3 := uint32(0)
-	var lastr3 uint32
-	for step := 0; ; step++ {
-		r1 := uint32(r3) | 0x10000
-		r3 = uint32(c0)
-
-		for r1 != 0 { // outer loop
-			r3 = (r3 + (r1 & 0xff)) & 0xffffff
-			r3 = (r3 * c1) & 0xffffff
-			r1 /= 256 // inner loop
-		}
-
-		if step == 0 {
-			fmt.Println("21/1:", r3)
-		}
+	// run the program until the comparison with r0 on line 28,
+	// with inner loops run as idioms
+	code := wristdev.Compile(p.Arch, prog)
 
-		if _, seen := r3m[r3]; !seen {
-			r3m[r3] = struct{}{}
-			lastr3 = r3
-		} else {
-			fmt.Println("21/2:", lastr3)
-			break
-		}
+	// r3 on line 28 determines the rest, find where its values repeat
+	const maxVisits, maxSteps = 1e6, 1e6
+	cyc, visits, ok := code.FindCycle(p.Arch.State(-1), 28, []int{3}, maxVisits, maxSteps)
+	if !ok {
+		return errors.New("no cycle of r3 found")
 	}
-}
-
-type aoc21simfunc func(ctx context.Context, r0 regt) <-chan simstate
-
-func merge21(ctx context.Context, r0 regt, fns []aoc21simfunc) <-chan []simstate {
-
-	chans := make([]<-chan simstate, len(fns))
-	for i, fn := range fns {
-		chans[i] = fn(ctx, r0)
+	if verbose {
+		fmt.Fprintf(rep.Diag, "r3 repeats after %d values with period %d\n", cyc.Tail, cyc.Period)
 	}
 
-	ch := make(chan []simstate)
-	go func() {
-		defer close(ch)
-
-		r := make([]simstate, len(chans))
-
-		for i := range r {
-			if chans[i] == nil {
-				continue
-			}
-
-			select {
-			case <-ctx.Done():
-				return
-			case s, ok := <-chans[i]:
-				r[i] = s
-				if !ok {
-					chans[i] = nil
-				}
-			}
-		}
-
-		select {
-		case <-ctx.Done():
-			return
-		case ch <- r:
-		}
-
-	}()
-
-	return ch
-}
-
-func aoc21simprog(ctx context.Context, r0 regt) <-chan simstate {
-	ipreg, prog := programInput(21)
-
-	const nreg = 6
-
-	arch := wristdev.ArchWithIP(nreg, ipreg)
-	state := arch.State(int(r0))
-
-	ch := make(chan simstate)
-	go func() {
-		defer close(ch)
-
-		for {
-			if !state.Step(prog) {
-				return
-			}
-			if *state.IP == 28 {
-				var st simstate
-				for i := 0; i < len(st); i++ {
-					st[i] = regt(state.R[i])
-				}
-				select {
-				case <-ctx.Done():
-					return
-				case ch <- st:
-				}
-			}
-		}
-	}()
+	// the first value halts the program fastest, and the last
+	// one before the first repeat halts it slowest
+	rep.Answer(1, visits[0][0])
+	rep.Answer(2, visits[len(visits)-1][0])
 
-	return ch
+	return nil
 }
 
 /*
@@ -280,30 +183,19 @@ Analysis:
 
 */
 
-type regt int
-
-type simstate [5]regt
-
-func yieldsimstate(ctx context.Context, ch chan simstate,
-	r0, r1, r2, r3, r4 regt) bool {
-	st := simstate{r0, r1, r2, r3, r4}
-	select {
-	case <-ctx.Done():
-		return true
-	case ch <- st:
-		return false
-	}
-}
-
-func aoc21sim0(ctx context.Context, r0 regt) <-chan simstate {
+// aoc21sim0 is a direct translation of the program
+// stepping between visits of line 28.
+func aoc21sim0(r0 int) wristdev.StepFunc {
+	var r1, r2, r3, r4 int
+	started := false
 
-	ch := make(chan simstate)
-	go func() {
-		defer close(ch)
-
-		var r1, r2, r3, r4 regt
+	return func() []int {
+		if started && r3 == r0 {
+			return nil
+		}
+		started = true
 
-	Line6: // begin main loop
+		// Line6: begin main loop, repeated by each call
 		r1 = r3 | 0x10000
 		r3 = 14906355
 
@@ -312,6 +204,7 @@ func aoc21sim0(ctx context.Context, r0 regt) <-chan simstate {
 		r3 = (r3 + r4) & 0xffffff
 		r3 = (r3 * 65899) & 0xffffff
 		if r1 < 256 {
+			r4 = 1
 			goto Line28
 		}
 		r4 = 0
@@ -330,55 +223,40 @@ func aoc21sim0(ctx context.Context, r0 regt) <-chan simstate {
 		goto Line8 // end outer loop
 
 	Line28:
-		if yieldsimstate(ctx, ch, r0, r1, r2, r3, r4) {
-			return
-		}
-		if r3 != r0 {
-			goto Line6
-		} // end main loop
-	}()
-
-	return ch
+		return []int{r0, r1, r2, r3, r4}
+	}
 }
 
-func aoc21sim1(ctx context.Context, r0 regt) <-chan simstate {
-
-	ch := make(chan simstate)
-	go func() {
-		defer close(ch)
-
-		var r1, r2, r3, r4 regt
-
-		// check 126&456 == 72
-
-		// r2 is not used below, and is always 1 on line 28
-		// r3 is set to ensure the loop starts
-		r2, r3 = 1, r0|0x10000
-
-		for r3 != r0 {
+// aoc21sim1 is a simplification of aoc21sim0.
+func aoc21sim1(r0 int) wristdev.StepFunc {
+	// check 126&456 == 72
 
-			r1 = r3 | 0x10000
-			r3 = 14906355
+	// r2 is not used below, and is always 1 on line 28
+	// r3 is set to ensure the loop starts
+	r2, r3 := 1, r0|0x10000
 
-			// simr1 needed to satisfy
-			var simr1 regt
+	return func() []int {
+		if r3 == r0 {
+			return nil
+		}
 
-			for r1 != 0 {
-				simr1 = r1
-				r3 = (r3 + (r1 & 0xff)) & 0xffffff
-				r3 = (r3 * 65899) & 0xffffff
-				r1 /= 256 // inner loop
-			}
+		r1 := r3 | 0x10000
+		r3 = 14906355
 
-			// satisy simstate
-			r1 = simr1
-			r4 = r1
+		// simr1 needed to satisfy the program state
+		var simr1 int
 
-			if yieldsimstate(ctx, ch, r0, r1, r2, r3, r4) {
-				return
-			}
+		for r1 != 0 {
+			simr1 = r1
+			r3 = (r3 + (r1 & 0xff)) & 0xffffff
+			r3 = (r3 * 65899) & 0xffffff
+			r1 /= 256 // inner loop
 		}
-	}()
 
-	return ch
+		// r4 holds the result of the comparison r1 < 256
+		r1 = simr1
+		r4 := 1
+
+		return []int{r0, r1, r2, r3, r4}
+	}
 }
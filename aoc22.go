@@ -1,20 +1,23 @@
 package main
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/tajtiattila/aoc18/modemaze"
 )
 
-func modemaze22() {
-	const (
-		// puzzle input:
-		// depth: 11109
-		// target: 9,731
+func modemaze22(rep *Report) error {
+	lines, err := PuzzleInputLines(22)
+	if err != nil {
+		return err
+	}
 
-		depth  = 11109
-		tx, ty = 9, 731
-	)
+	depth, tx, ty, err := parsemodemaze(lines)
+	if err != nil {
+		return err
+	}
 
 	m := modemaze.New(depth, tx, ty)
 
@@ -22,6 +25,26 @@ func modemaze22() {
 
 	//m.Write(os.Stdout, 800, 800)
 
-	fmt.Println("22/1:", m.RiskLevel())
-	fmt.Println("22/2:", m.PathDuration())
+	rep.Answer(1, m.RiskLevel())
+
+	minutes, err := m.PathDuration(context.Background())
+	if err != nil {
+		return err
+	}
+	rep.Answer(2, minutes)
+	return nil
+}
+
+// parsemodemaze parses the depth and target of the cave.
+func parsemodemaze(lines []string) (depth, tx, ty int, err error) {
+	if len(lines) < 2 {
+		return 0, 0, 0, errors.New("depth or target missing")
+	}
+	if _, err := fmt.Sscanf(lines[0], "depth: %d", &depth); err != nil {
+		return 0, 0, 0, errors.Wrap(err, "invalid depth")
+	}
+	if _, err := fmt.Sscanf(lines[1], "target: %d,%d", &tx, &ty); err != nil {
+		return 0, 0, 0, errors.Wrap(err, "invalid target")
+	}
+	return depth, tx, ty, nil
 }
@@ -2,27 +2,34 @@ package main
 
 import (
 	"fmt"
-	"log"
 	"sort"
 
+	"github.com/pkg/errors"
 	"github.com/tajtiattila/aoc18/bitset"
 	"github.com/tajtiattila/aoc18/nanobot"
 )
 
-func teleport23() {
-	v := getnanobots()
+func teleport23(rep *Report) error {
+	v, err := getnanobots()
+	if err != nil {
+		return err
+	}
 
 	maxr := bestradius(v)
 	if verbose {
 		b := v[maxr]
-		fmt.Printf("maxr: (%d) pos=<%d,%d,%d> r=%d\n", maxr, b.X, b.Y, b.Z, b.Radius)
+		fmt.Fprintf(rep.Diag, "maxr: (%d) pos=<%d,%d,%d> r=%d\n", maxr, b.X, b.Y, b.Z, b.Radius)
 	}
-	fmt.Println("23/1:", maxinrange(v, maxr))
-	findbest23(v)
+	rep.Answer(1, maxinrange(v, maxr))
+	rep.Answer(2, findbest23(v))
+	return nil
 }
 
-func getnanobots() []nanobot.Bot {
-	lines := PuzzleInputLines(23)
+func getnanobots() ([]nanobot.Bot, error) {
+	lines, err := PuzzleInputLines(23)
+	if err != nil {
+		return nil, err
+	}
 
 	var v []nanobot.Bot
 	for i, l := range lines {
@@ -30,12 +37,12 @@ func getnanobots() []nanobot.Bot {
 		//pos=<1,1,1>, r=1
 		_, err := fmt.Sscanf(l, "pos=<%d,%d,%d>, r=%d", &n.X, &n.Y, &n.Z, &n.Radius)
 		if err != nil {
-			log.Fatalf("parse line %d: %v", i+1, err)
+			return nil, errors.Wrapf(err, "parse line %d", i+1)
 		}
 
 		v = append(v, n)
 	}
-	return v
+	return v, nil
 }
 
 func bestradius(v []nanobot.Bot) int {
@@ -71,7 +78,7 @@ func abs(x int) int {
 	return -x
 }
 
-func findbest23(src []nanobot.Bot) {
+func findbest23(src []nanobot.Bot) int {
 	type boti struct {
 		c nanobot.MPoint
 		r int
@@ -194,5 +201,5 @@ func findbest23(src []nanobot.Bot) {
 
 	rec(0, bounds, bitset.Ones(len(bots)))
 
-	fmt.Println("23/2:", best.dist)
+	return best.dist
 }
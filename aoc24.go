@@ -2,25 +2,28 @@ package main
 
 import (
 	"fmt"
-	"log"
 	"sort"
 
+	"github.com/pkg/errors"
 	"github.com/tajtiattila/aoc18/immunesys"
 )
 
-func immunesysbattle() {
-	pi := OpenPuzzleInput(24)
+func immunesysbattle(rep *Report) error {
+	pi, err := OpenPuzzleInput(24)
+	if err != nil {
+		return err
+	}
 	defer pi.Close()
 
 	battle, err := immunesys.ParseBattle(pi)
 	if err != nil {
-		log.Fatal("parse input:", err)
+		return errors.Wrap(err, "parse input")
 	}
 
 	b := battle.Clone()
 	b.Run()
 
-	fmt.Println("24/1:", b.TotalUnitCount())
+	rep.Answer(1, b.TotalUnitCount())
 
 	const wantWinner = "Immune System"
 
@@ -30,7 +33,7 @@ func immunesysbattle() {
 		b.Boost(wantWinner, hi)
 		winner, ok := b.Run()
 		if verbose {
-			fmt.Println(hi, winner, ok)
+			fmt.Fprintln(rep.Diag, hi, winner, ok)
 		}
 		if !ok || winner != wantWinner {
 			lo, hi = hi, hi*2
@@ -44,10 +47,10 @@ func immunesysbattle() {
 
 		b := battle.Clone()
 		b.Boost(wantWinner, boost)
-		//b.ShowHeader(verbosew)
+		//b.ShowHeader(rep.Diag)
 		winner, ok := b.Run()
 		if verbose {
-			fmt.Println(boost, winner, ok)
+			fmt.Fprintln(rep.Diag, boost, winner, ok)
 		}
 		return ok && winner == wantWinner
 	})
@@ -56,5 +59,6 @@ func immunesysbattle() {
 	b.Boost(wantWinner, needBoost)
 	b.Run()
 
-	fmt.Println("24/2:", b.TotalUnitCount())
+	rep.Answer(2, b.TotalUnitCount())
+	return nil
 }
@@ -1,18 +1,21 @@
 package main
 
 import (
-	"fmt"
-	"log"
-
 	"github.com/tajtiattila/aoc18/constellation"
 )
 
-func constellations() {
-	points, err := constellation.ParsePoints(PuzzleInputLines(25))
+func constellations(rep *Report) error {
+	lines, err := PuzzleInputLines(25)
+	if err != nil {
+		return err
+	}
+
+	points, err := constellation.ParsePoints(lines)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	c := constellation.Constellations(points, 3)
-	fmt.Println("25/1:", len(c))
+	rep.Answer(1, len(c))
+	return nil
 }
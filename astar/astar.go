@@ -1,21 +1,14 @@
 package astar
 
-import "container/heap"
-
-// Point represents a position in the problem space,
-// such as location and additional attributes.
-//
-// Concrete types of Point must be equality comparable.
-type Point interface{}
-
-// Adjacent finds states adjacent to p,
-// appending them to dst.
-type AdjacentFunc func(p Point, dst []State) (adjacents []State)
+import (
+	"context"
+	"fmt"
+)
 
-// State is returned by Space.Adjacent to
-// report neighbour points and costs.
-type State struct {
-	Point Point
+// Step is returned by the Adjacent function of Search
+// to report a neighbour point and costs.
+type Step[P comparable] struct {
+	Point P
 
 	// Cost is the cost spent to reach this Point
 	// from the last point.
@@ -29,114 +22,567 @@ type State struct {
 	// It must be a minimum estimate; i.e.
 	// any possible path should cost at least this amount.
 	//
-	// A zero estimate means that the goal is reached.
+	// A zero estimate means that the goal is reached,
+	// unless Search.Goal is set.
 	EstimateLeft int
 }
 
-type pointStat struct {
-	point Point
+const (
+	maxUint = ^uint(0)
+	maxInt  = int(maxUint >> 1)
+)
 
-	totalCost int // total cost to reach this point
-	estimate  int // totalCost + EstimateLeft
-}
+// Search is an A* search over points of type P,
+// such as location and additional attributes.
+type Search[P comparable] struct {
+	// Adjacent finds steps to points adjacent to p,
+	// appending them to dst.
+	Adjacent func(p P, dst []Step[P]) []Step[P]
+
+	// Less, if not nil, orders points having equal estimates.
+	// Points for which Less returns true are expanded first.
+	//
+	// Points that are still equal are expanded
+	// in the order they were found.
+	Less func(a, b P) bool
 
-type statEntry struct {
-	from Point // predecessor in path
+	// Index, if not nil, maps points to dense non-negative indices,
+	// so that search state can be kept in slices instead of a map.
+	// Index must return distinct values for distinct points.
+	Index func(p P) int
 
-	totalCost int // total cost to reach this point
+	// StopAtGoal makes the search stop at the first goal found.
+	// The path to it may not be the best one.
+	StopAtGoal bool
+
+	// MaxCost, if positive, is the maximum cost of paths explored.
+	MaxCost int
+
+	// MaxExpanded, if positive, is the maximum number
+	// of points expanded before the search gives up.
+	MaxExpanded int
+
+	// Goal, if not nil, reports if p is a goal.
+	// Otherwise points with zero Step.EstimateLeft are goals.
+	Goal func(p P) bool
+
+	// AllPaths makes AllGoals record all
+	// shortest paths to the goals in a DAG.
+	AllPaths bool
+
+	slices *sliceStore[P] // state reused between runs if Index is set
 }
 
+// StopReason tells why a search stopped without a result.
+type StopReason int
+
 const (
-	maxUint = ^uint(0)
-	maxInt  = int(maxUint >> 1)
+	// NoPath means no goal is reachable from start.
+	NoPath StopReason = iota
+
+	// CostBound means no goal is reachable within Search.MaxCost.
+	CostBound
+
+	// ExpandLimit means Search.MaxExpanded points were expanded.
+	ExpandLimit
+
+	// Canceled means the context of the search was done.
+	Canceled
 )
 
-func FindPath(start Point,
-	adjacent func(p Point, dst []State) (adjacents []State)) (path []Point, cost int) {
+func (r StopReason) String() string {
+	switch r {
+	case NoPath:
+		return "no path"
+	case CostBound:
+		return "cost bound"
+	case ExpandLimit:
+		return "expand limit"
+	case Canceled:
+		return "canceled"
+	}
+	return fmt.Sprintf("StopReason(%d)", int(r))
+}
+
+// StopError is returned by Search.Run if
+// it could not find the best path to a goal.
+type StopError struct {
+	Reason StopReason
+
+	// Limit is the value of MaxCost or MaxExpanded that was hit.
+	Limit int
+
+	// Err is the context error for Canceled.
+	Err error
+}
+
+func (e *StopError) Error() string {
+	switch e.Reason {
+	case CostBound:
+		return fmt.Sprintf("astar: no path within cost %d", e.Limit)
+	case ExpandLimit:
+		return fmt.Sprintf("astar: %d points expanded without finding best path", e.Limit)
+	case Canceled:
+		return fmt.Sprintf("astar: canceled: %v", e.Err)
+	}
+	return "astar: " + e.Reason.String()
+}
+
+// Cause returns the context error of a canceled search.
+func (e *StopError) Cause() error { return e.Err }
+
+// Unwrap returns the context error of a canceled search.
+func (e *StopError) Unwrap() error { return e.Err }
+
+// Result is the outcome of a Search.
+type Result[P comparable] struct {
+	// Path is the best path found from start to goal,
+	// including both. It is nil if no goal was found.
+	Path []P
+
+	// Cost is the total cost of Path.
+	Cost int
+
+	// Expanded is the number of points expanded
+	// during the search.
+	Expanded int
+}
+
+// Found reports if a path to a goal was found.
+func (r Result[P]) Found() bool {
+	return r.Path != nil
+}
+
+// ctxCheckInterval is the number of points expanded
+// between checks of the search context.
+const ctxCheckInterval = 256
 
-	m := make(map[Point]statEntry)
-	m[start] = statEntry{}
+// Run finds the best path from start to a goal.
+//
+// If the best path could not be found, Run returns a *StopError.
+// Then the result still holds the best path found so far, if any.
+func (s *Search[P]) Run(ctx context.Context, start P) (Result[P], error) {
+	sr, err := s.search(ctx, start, false)
+
+	res := Result[P]{Expanded: sr.expanded}
+	if len(sr.goals) == 0 {
+		return res, err
+	}
 
-	active := activeHeap{
-		pointStat{
-			point: start,
-		},
+	res.Cost = sr.bestCost
+	for p := sr.goals[0]; ; {
+		res.Path = append(res.Path, p)
+		e, _ := sr.st.get(p)
+		if !e.hasFrom {
+			break
+		}
+		p = e.from
+	}
+
+	// reverse path
+	path := res.Path
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
 	}
 
+	return res, err
+}
+
+// Goals is the outcome of Search.AllGoals.
+type Goals[P comparable] struct {
+	// Points are the goals reachable at minimal cost,
+	// in the order they were found.
+	Points []P
+
+	// Cost is the cost to reach the goals.
+	Cost int
+
+	// Expanded is the number of points expanded
+	// during the search.
+	Expanded int
+
+	// DAG holds the shortest paths to Points
+	// if Search.AllPaths was set.
+	//
+	// If Search.Index is set, DAG is valid
+	// until the Search is run again.
+	DAG *DAG[P]
+}
+
+// AllGoals finds all goals reachable from start at minimal cost.
+//
+// It returns errors like Run.
+func (s *Search[P]) AllGoals(ctx context.Context, start P) (Goals[P], error) {
+	sr, err := s.search(ctx, start, true)
+
+	res := Goals[P]{
+		Points:   sr.goals,
+		Expanded: sr.expanded,
+	}
+	if len(sr.goals) != 0 {
+		res.Cost = sr.bestCost
+	}
+	if s.AllPaths {
+		res.DAG = &DAG[P]{start: start, st: sr.st, edges: sr.edges}
+	}
+	return res, err
+}
+
+// DAG is the directed acyclic graph of shortest paths from a start point.
+type DAG[P comparable] struct {
+	start P
+	st    pointStore[P]
+	edges []predEdge[P]
+}
+
+// Pred returns the predecessors of p on shortest paths from start.
+func (d *DAG[P]) Pred(p P) []P {
+	e, _ := d.st.get(p)
+	var v []P
+	for i := e.pred; i != 0; i = d.edges[i-1].next {
+		v = append(v, d.edges[i-1].p)
+	}
+	return v
+}
+
+// FirstSteps returns the points following start
+// on the shortest paths from start to goal.
+func (d *DAG[P]) FirstSteps(goal P) []P {
+	if goal == d.start {
+		return nil
+	}
+
+	var steps []P
+	seen := map[P]bool{goal: true}
+	active := []P{goal}
+	for len(active) > 0 {
+		p := active[len(active)-1]
+		active = active[:len(active)-1]
+		for _, q := range d.Pred(p) {
+			if q == d.start {
+				steps = append(steps, p)
+				continue
+			}
+			if !seen[q] {
+				seen[q] = true
+				active = append(active, q)
+			}
+		}
+	}
+	return steps
+}
+
+// searchResult is the state of a finished search.
+type searchResult[P comparable] struct {
+	st pointStore[P]
+
+	goals    []P // goals found at bestCost
+	bestCost int
+
+	expanded int
+
+	edges []predEdge[P] // predecessor lists of Search.AllPaths
+}
+
+// predEdge is an element of a predecessor list.
+type predEdge[P comparable] struct {
+	p    P
+	next int // 1-based index of next edge, or 0
+}
+
+// addPred adds p to the predecessor list starting at
+// the 1-based edge index list, and returns the new list.
+func (sr *searchResult[P]) addPred(p P, list int) int {
+	sr.edges = append(sr.edges, predEdge[P]{p: p, next: list})
+	return len(sr.edges)
+}
+
+// search runs the search from start. If all is set, it finds
+// all goals at minimal cost, otherwise it stops at the first one.
+func (s *Search[P]) search(ctx context.Context, start P, all bool) (searchResult[P], error) {
+	var sr searchResult[P]
+	if s.Index != nil {
+		if s.slices == nil {
+			s.slices = new(sliceStore[P])
+		}
+		s.slices.reset(s.Index)
+		sr.st.s = s.slices
+	} else {
+		sr.st.m = make(map[P]entry[P])
+	}
+	sr.st.set(start, entry[P]{})
+
+	active := &searchHeap[P]{pless: s.Less}
+	active.push(searchNode[P]{point: start})
+
 	// bestCost is the cost of the best path
 	// (smallest cost) found so far
-	bestCost := maxInt
-	var bestGoal Point
+	sr.bestCost = maxInt
 
-	var states []State
-	for len(active) > 0 {
-		a := heap.Pop(&active).(pointStat)
+	bound := maxInt
+	if s.MaxCost > 0 {
+		bound = s.MaxCost
+	}
+	var hitBound bool
+
+	var (
+		err   error
+		steps []Step[P]
+	)
+search:
+	for len(active.v) > 0 {
+		a := active.pop()
 
-		states = adjacent(a.point, states[:0])
+		if a.estimate > sr.bestCost || (!all && a.estimate == sr.bestCost) {
+			// no better path remains
+			break
+		}
 
-		for _, s := range states {
-			if s.Cost <= 0 {
+		if e, _ := sr.st.get(a.point); a.totalCost > e.totalCost {
+			// a better path to this point was found
+			// after this node had been pushed
+			continue
+		}
+
+		if s.MaxExpanded > 0 && sr.expanded >= s.MaxExpanded {
+			err = &StopError{Reason: ExpandLimit, Limit: s.MaxExpanded}
+			break
+		}
+
+		if sr.expanded%ctxCheckInterval == 0 {
+			if cerr := ctx.Err(); cerr != nil {
+				err = &StopError{Reason: Canceled, Err: cerr}
+				break
+			}
+		}
+
+		sr.expanded++
+		steps = s.Adjacent(a.point, steps[:0])
+
+		for _, x := range steps {
+			if x.Cost <= 0 {
 				panic("cost must be positive")
 			}
 
-			tc := a.totalCost + s.Cost
-			est := tc + s.EstimateLeft
-			if est > bestCost {
+			tc := a.totalCost + x.Cost
+			est := tc + x.EstimateLeft
+			if est > sr.bestCost {
 				// this way we can't get better
 				continue
 			}
 
-			if s.EstimateLeft == 0 {
-				// goal reached
-				if tc < bestCost {
-					bestCost, bestGoal = tc, s.Point
-				}
+			if est > bound {
+				hitBound = true
+				continue
 			}
 
-			x, ok := m[s.Point]
-			if !ok || tc < x.totalCost {
-				m[s.Point] = statEntry{
+			e, ok := sr.st.get(x.Point)
+			better := !ok || tc < e.totalCost
+
+			goal := s.isGoal(x)
+			switch {
+			case goal && tc < sr.bestCost:
+				sr.bestCost, sr.goals = tc, append(sr.goals[:0], x.Point)
+			case goal && all && tc == sr.bestCost && better:
+				sr.goals = append(sr.goals, x.Point)
+			default:
+				goal = false
+			}
+
+			if better {
+				e = entry[P]{
 					from:      a.point,
+					hasFrom:   true,
 					totalCost: tc,
 				}
+				if s.AllPaths {
+					e.pred = sr.addPred(a.point, 0)
+				}
+				sr.st.set(x.Point, e)
 
-				heap.Push(&active, pointStat{
-					point:     s.Point,
+				active.push(searchNode[P]{
+					point:     x.Point,
 					totalCost: tc,
 					estimate:  est,
 				})
+			} else if s.AllPaths && tc == e.totalCost {
+				e.pred = sr.addPred(a.point, e.pred)
+				sr.st.set(x.Point, e)
+			}
+
+			if goal && s.StopAtGoal {
+				break search
 			}
 		}
 	}
 
-	for p := bestGoal; p != nil; p = m[p].from {
-		path = append(path, p)
+	if len(sr.goals) == 0 && err == nil {
+		if hitBound {
+			err = &StopError{Reason: CostBound, Limit: s.MaxCost}
+		} else {
+			err = &StopError{Reason: NoPath}
+		}
 	}
+	return sr, err
+}
 
-	// reverse path
-	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
-		path[i], path[j] = path[j], path[i]
+func (s *Search[P]) isGoal(x Step[P]) bool {
+	if s.Goal != nil {
+		return s.Goal(x.Point)
 	}
+	return x.EstimateLeft == 0
+}
+
+type entry[P comparable] struct {
+	from    P    // predecessor in path
+	hasFrom bool // false for start
+
+	pred int // predecessor list on shortest paths, see searchResult.addPred
 
-	return path, bestCost
+	totalCost int // total cost to reach this point
 }
 
-type activeHeap []pointStat
+// pointStore holds the search state of points,
+// in a map or if Search.Index is set, in slices.
+type pointStore[P comparable] struct {
+	m map[P]entry[P]
+	s *sliceStore[P]
+}
 
-func (h activeHeap) Len() int      { return len(h) }
-func (h activeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (st pointStore[P]) get(p P) (entry[P], bool) {
+	if st.s != nil {
+		return st.s.get(p)
+	}
+	e, ok := st.m[p]
+	return e, ok
+}
+
+func (st pointStore[P]) set(p P, e entry[P]) {
+	if st.s != nil {
+		st.s.set(p, e)
+	} else {
+		st.m[p] = e
+	}
+}
+
+// sliceStore holds the search state of points with dense indices.
+//
+// It is kept in Search between runs so its slices can be reused.
+type sliceStore[P comparable] struct {
+	index func(p P) int
+
+	v   []entry[P]
+	gen []uint32 // entry in v is valid if its gen is curgen
+
+	curgen uint32
+}
+
+// reset clears s for a new search.
+func (s *sliceStore[P]) reset(index func(p P) int) {
+	s.index = index
+	s.curgen++
+	if s.curgen == 0 {
+		// wrapped around
+		for i := range s.gen {
+			s.gen[i] = 0
+		}
+		s.curgen = 1
+	}
+}
+
+func (s *sliceStore[P]) get(p P) (entry[P], bool) {
+	i := s.index(p)
+	if i >= len(s.v) || s.gen[i] != s.curgen {
+		return entry[P]{}, false
+	}
+	return s.v[i], true
+}
+
+func (s *sliceStore[P]) set(p P, e entry[P]) {
+	i := s.index(p)
+	if i >= len(s.v) {
+		n := 2 * len(s.v)
+		if n <= i {
+			n = 2 * (i + 1)
+		}
+		v := make([]entry[P], n)
+		copy(v, s.v)
+		s.v = v
+		gen := make([]uint32, n)
+		copy(gen, s.gen)
+		s.gen = gen
+	}
+	s.v[i], s.gen[i] = e, s.curgen
+}
 
-func (h activeHeap) Less(i, j int) bool {
-	return h[i].estimate < h[j].estimate
+type searchNode[P comparable] struct {
+	point P
+
+	totalCost int // total cost to reach this point
+	estimate  int // totalCost + EstimateLeft
+
+	seq int // order of push
 }
 
-func (h *activeHeap) Push(x interface{}) {
-	*h = append(*h, x.(pointStat))
+type searchHeap[P comparable] struct {
+	v     []searchNode[P]
+	pless func(a, b P) bool
+
+	nseq int
 }
 
-func (h *activeHeap) Pop() interface{} {
-	n := len(*h) - 1
-	e := (*h)[n]
-	*h = (*h)[:n]
-	return e
+func (h *searchHeap[P]) push(n searchNode[P]) {
+	n.seq = h.nseq
+	h.nseq++
+
+	// sift up
+	h.v = append(h.v, n)
+	for j := len(h.v) - 1; j > 0; {
+		i := (j - 1) / 2 // parent
+		if !h.less(j, i) {
+			break
+		}
+		h.v[i], h.v[j] = h.v[j], h.v[i]
+		j = i
+	}
+}
+
+func (h *searchHeap[P]) pop() searchNode[P] {
+	n := len(h.v) - 1
+	top := h.v[0]
+	h.v[0] = h.v[n]
+	h.v = h.v[:n]
+
+	// sift down
+	for i := 0; ; {
+		j := 2*i + 1 // left child
+		if j >= n {
+			break
+		}
+		if r := j + 1; r < n && h.less(r, j) {
+			j = r
+		}
+		if !h.less(j, i) {
+			break
+		}
+		h.v[i], h.v[j] = h.v[j], h.v[i]
+		i = j
+	}
+	return top
+}
+
+func (h *searchHeap[P]) less(i, j int) bool {
+	a, b := &h.v[i], &h.v[j]
+	if a.estimate != b.estimate {
+		return a.estimate < b.estimate
+	}
+	if h.pless != nil {
+		if h.pless(a.point, b.point) {
+			return true
+		}
+		if h.pless(b.point, a.point) {
+			return false
+		}
+	}
+	return a.seq < b.seq
 }
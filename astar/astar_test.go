@@ -0,0 +1,230 @@
+package astar
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type gridPoint struct{ x, y int }
+
+// gridSearch searches the 4-connected grid
+// from top left to bottom right, avoiding '#' tiles.
+func gridSearch(grid []string, dense bool) Search[gridPoint] {
+	dx, dy := len(grid[0]), len(grid)
+	goal := gridPoint{dx - 1, dy - 1}
+
+	s := Search[gridPoint]{
+		Adjacent: func(p gridPoint, dst []Step[gridPoint]) []Step[gridPoint] {
+			for _, d := range []gridPoint{{0, -1}, {-1, 0}, {1, 0}, {0, 1}} {
+				q := gridPoint{p.x + d.x, p.y + d.y}
+				if q.x < 0 || q.y < 0 || q.x >= dx || q.y >= dy || grid[q.y][q.x] == '#' {
+					continue
+				}
+				dst = append(dst, Step[gridPoint]{
+					Point:        q,
+					Cost:         1,
+					EstimateLeft: abs(goal.x-q.x) + abs(goal.y-q.y),
+				})
+			}
+			return dst
+		},
+	}
+	if dense {
+		s.Index = func(p gridPoint) int { return p.x + p.y*dx }
+	}
+	return s
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func TestSearch(t *testing.T) {
+	grid := []string{
+		"....",
+		".##.",
+		".#..",
+		"...#",
+		"#...",
+	}
+
+	for _, dense := range []bool{false, true} {
+		s := gridSearch(grid, dense)
+		res, err := s.Run(context.Background(), gridPoint{})
+		if err != nil {
+			t.Fatalf("dense=%v: %v", dense, err)
+		}
+		if res.Cost != 7 || len(res.Path) != 8 {
+			t.Errorf("dense=%v: got cost %d, path %v", dense, res.Cost, res.Path)
+		}
+		if res.Expanded == 0 {
+			t.Errorf("dense=%v: no points expanded", dense)
+		}
+	}
+}
+
+func TestSearchTieBreak(t *testing.T) {
+	grid := []string{
+		"...",
+		"...",
+		"...",
+	}
+
+	// prefer points in reading order
+	s := gridSearch(grid, false)
+	s.Less = func(a, b gridPoint) bool {
+		if a.y != b.y {
+			return a.y < b.y
+		}
+		return a.x < b.x
+	}
+
+	res, err := s.Run(context.Background(), gridPoint{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := fmt.Sprint(res.Path)
+	want := "[{0 0} {1 0} {2 0} {2 1} {2 2}]"
+	if got != want {
+		t.Errorf("got path %s; want %s", got, want)
+	}
+}
+
+func TestSearchNoPath(t *testing.T) {
+	grid := []string{
+		".#",
+		"#.",
+	}
+	s := gridSearch(grid, true)
+	res, err := s.Run(context.Background(), gridPoint{})
+	if res.Found() {
+		t.Errorf("got path %v; want none", res.Path)
+	}
+	if se, ok := err.(*StopError); !ok || se.Reason != NoPath {
+		t.Errorf("got error %v; want no path", err)
+	}
+}
+
+var mazeGrid = []string{
+	".......",
+	"######.",
+	".......",
+	".######",
+	".......",
+}
+
+func TestSearchLimits(t *testing.T) {
+	ctx := context.Background()
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		opt    func(s *Search[gridPoint])
+		reason StopReason
+	}{
+		{"cost", ctx, func(s *Search[gridPoint]) { s.MaxCost = 20 }, CostBound},
+		{"expand", ctx, func(s *Search[gridPoint]) { s.MaxExpanded = 10 }, ExpandLimit},
+		{"cancel", canceled, func(s *Search[gridPoint]) {}, Canceled},
+	}
+
+	for _, tt := range tests {
+		s := gridSearch(mazeGrid, true)
+		tt.opt(&s)
+		res, err := s.Run(tt.ctx, gridPoint{})
+		se, ok := err.(*StopError)
+		if !ok || se.Reason != tt.reason {
+			t.Errorf("%s: got error %v; want %v", tt.name, err, tt.reason)
+		}
+		if res.Found() {
+			t.Errorf("%s: got path %v; want none", tt.name, res.Path)
+		}
+	}
+
+	s := gridSearch(mazeGrid, true)
+	s.MaxCost = 22
+	if res, err := s.Run(ctx, gridPoint{}); err != nil || res.Cost != 22 {
+		t.Errorf("cost bound 22: got %d, %v", res.Cost, err)
+	}
+}
+
+func TestSearchStopAtGoal(t *testing.T) {
+	s := gridSearch(mazeGrid, false)
+	full, err := s.Run(context.Background(), gridPoint{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.StopAtGoal = true
+	res, err := s.Run(context.Background(), gridPoint{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Cost != full.Cost || res.Expanded > full.Expanded {
+		t.Errorf("got cost %d after %d expanded; full search %d after %d",
+			res.Cost, res.Expanded, full.Cost, full.Expanded)
+	}
+}
+
+// bfs makes s ignore its estimates.
+func bfs(s *Search[gridPoint]) {
+	adj := s.Adjacent
+	s.Adjacent = func(p gridPoint, dst []Step[gridPoint]) []Step[gridPoint] {
+		n := len(dst)
+		dst = adj(p, dst)
+		for i := n; i < len(dst); i++ {
+			dst[i].EstimateLeft = 0
+		}
+		return dst
+	}
+}
+
+func TestSearchAllGoals(t *testing.T) {
+	grid := []string{
+		".....",
+		".#.#.",
+		".....",
+	}
+
+	s := gridSearch(grid, true)
+	bfs(&s)
+	goals := map[gridPoint]bool{{4, 0}: true, {0, 2}: true, {4, 2}: true}
+	s.Goal = func(p gridPoint) bool { return goals[p] }
+	s.AllPaths = true
+
+	start := gridPoint{1, 0}
+	res, err := s.AllGoals(context.Background(), start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Cost != 3 || len(res.Points) != 2 {
+		t.Errorf("got goals %v at cost %d; want 2 goals at 3", res.Points, res.Cost)
+	}
+
+	steps := fmt.Sprint(res.DAG.FirstSteps(gridPoint{0, 2}))
+	if steps != "[{0 0}]" {
+		t.Errorf("got first steps %s; want [{0 0}]", steps)
+	}
+
+	goal := gridPoint{2, 1}
+	s.Goal = func(p gridPoint) bool { return p == goal }
+	res, err = s.AllGoals(context.Background(), gridPoint{0, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Cost != 4 || len(res.Points) != 1 {
+		t.Errorf("got goals %v at cost %d; want 1 goal at 4", res.Points, res.Cost)
+	}
+	steps = fmt.Sprint(res.DAG.FirstSteps(goal))
+	if steps != "[{0 2} {0 0}]" && steps != "[{0 0} {0 2}]" {
+		t.Errorf("got first steps %s; want {0 0} and {0 2}", steps)
+	}
+	if pred := res.DAG.Pred(goal); len(pred) != 2 {
+		t.Errorf("got predecessors of goal %v; want 2", pred)
+	}
+}
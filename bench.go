@@ -0,0 +1,214 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"runtime/metrics"
+	"runtime/pprof"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PartStat holds resource usage of a puzzle part.
+type PartStat struct {
+	Key AnswerKey
+
+	Wall time.Duration
+
+	Allocs     uint64 // number of heap objects allocated
+	AllocBytes uint64 // bytes allocated
+
+	PeakHeap uint64 // peak size of live and unswept heap objects
+}
+
+// benchMeter records statistics of puzzle parts.
+//
+// A part ends when its answer is reported.
+type benchMeter struct {
+	mem runtime.MemStats
+
+	peak *heapSampler
+
+	stats []PartStat
+}
+
+func newBenchMeter() *benchMeter {
+	runtime.GC()
+
+	m := &benchMeter{
+		peak: startHeapSampler(time.Millisecond),
+	}
+	runtime.ReadMemStats(&m.mem)
+	return m
+}
+
+// record is used as a Report hook.
+func (m *benchMeter) record(res *Result) {
+	peak := m.peak.reset()
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	m.stats = append(m.stats, PartStat{
+		Key:        AnswerKey{Day: res.Day, Part: res.Part},
+		Wall:       res.Duration,
+		Allocs:     mem.Mallocs - m.mem.Mallocs,
+		AllocBytes: mem.TotalAlloc - m.mem.TotalAlloc,
+		PeakHeap:   peak,
+	})
+
+	m.mem = mem
+}
+
+func (m *benchMeter) Close() {
+	m.peak.stop()
+}
+
+// heapSampler samples heap size periodically to find its peak.
+type heapSampler struct {
+	mu   sync.Mutex
+	peak uint64
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+const heapObjectsMetric = "/memory/classes/heap/objects:bytes"
+
+func startHeapSampler(period time.Duration) *heapSampler {
+	s := &heapSampler{done: make(chan struct{})}
+	s.sample()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		t := time.NewTicker(period)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-t.C:
+				s.sample()
+			}
+		}
+	}()
+
+	return s
+}
+
+func (s *heapSampler) sample() {
+	m := []metrics.Sample{{Name: heapObjectsMetric}}
+	metrics.Read(m)
+	if m[0].Value.Kind() != metrics.KindUint64 {
+		return
+	}
+
+	v := m[0].Value.Uint64()
+
+	s.mu.Lock()
+	if v > s.peak {
+		s.peak = v
+	}
+	s.mu.Unlock()
+}
+
+// reset returns the peak since the last reset.
+func (s *heapSampler) reset() uint64 {
+	s.sample()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.peak
+	s.peak = 0
+	return p
+}
+
+func (s *heapSampler) stop() {
+	close(s.done)
+	s.wg.Wait()
+}
+
+// benchPuzzles runs puzzles and writes a report
+// of resource usage per part to w.
+func benchPuzzles(w io.Writer, puzzles []puzzle) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "puzzle\ttime\tallocs\tbytes\tpeak heap\t")
+	for _, p := range puzzles {
+		bm := newBenchMeter()
+		rep := newReport(p.n)
+		rep.hook = bm.record
+		err := runPuzzle(p, rep)
+		bm.Close()
+
+		if err != nil {
+			log.Printf("puzzle %d failed: %v", p.n, err)
+		}
+
+		for _, s := range bm.stats {
+			fmt.Fprintf(tw, "%v\t%v\t%d\t%s\t%s\t\n", s.Key,
+				s.Wall.Round(time.Microsecond), s.Allocs,
+				fmtBytes(s.AllocBytes), fmtBytes(s.PeakHeap))
+		}
+	}
+	return tw.Flush()
+}
+
+func fmtBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// profilePuzzle runs p reporting answers to rep. It writes a
+// CPU profile to cpufn and a heap profile to memfn,
+// unless they are empty.
+func profilePuzzle(rep *Report, p puzzle, cpufn, memfn string) error {
+	if cpufn != "" {
+		f, err := os.Create(cpufn)
+		if err != nil {
+			return errors.Wrap(err, "create CPU profile")
+		}
+		defer f.Close()
+
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return errors.Wrap(err, "start CPU profile")
+		}
+	}
+
+	perr := runPuzzle(p, rep)
+
+	if cpufn != "" {
+		pprof.StopCPUProfile()
+	}
+
+	if memfn != "" {
+		f, err := os.Create(memfn)
+		if err != nil {
+			return errors.Wrap(err, "create heap profile")
+		}
+		defer f.Close()
+
+		runtime.GC()
+		if err := pprof.WriteHeapProfile(f); err != nil {
+			return errors.Wrap(err, "write heap profile")
+		}
+	}
+
+	return perr
+}
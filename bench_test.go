@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestBenchMeter(t *testing.T) {
+	m := newBenchMeter()
+	rep := newReport(7)
+	rep.hook = m.record
+	rep.Answer(1, "ABC")
+	rep.Answer(2, "#..\n.#.\n")
+	m.Close()
+
+	if len(m.stats) != 2 {
+		t.Fatalf("got %d part stats; want 2", len(m.stats))
+	}
+	for i, s := range m.stats {
+		want := AnswerKey{Day: 7, Part: i + 1}
+		if s.Key != want {
+			t.Errorf("stat %d is for %v; want %v", i, s.Key, want)
+		}
+	}
+}
+
+func TestFmtBytes(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{3 << 20, "3.0 MiB"},
+	}
+	for _, tt := range tests {
+		if got := fmtBytes(tt.n); got != tt.want {
+			t.Errorf("fmtBytes(%d) got %q; want %q", tt.n, got, tt.want)
+		}
+	}
+}
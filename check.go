@@ -0,0 +1,205 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+)
+
+// AnswerKey identifies the answer of a puzzle part.
+type AnswerKey struct {
+	Day, Part int
+}
+
+func (k AnswerKey) String() string { return fmt.Sprintf("%d/%d", k.Day, k.Part) }
+
+// Answers maps puzzle parts to answers.
+type Answers map[AnswerKey]string
+
+var answerLineRe = regexp.MustCompile(`^(\d+)/(\d+):\s?(.*)$`)
+
+// ParseAnswers parses answers in the format printed by the puzzles,
+// so the output of a good run can be used as a golden answers file.
+//
+// An answer starts on a "day/part:" line, and continues on
+// subsequent lines until the next answer, to allow
+// multi-line answers such as that of day 10.
+// Surrounding white space of answers is ignored.
+func ParseAnswers(r io.Reader) (Answers, error) {
+	m := make(Answers)
+
+	var (
+		key   AnswerKey
+		lines []string
+	)
+
+	flush := func() {
+		if key.Day != 0 {
+			m[key] = strings.TrimSpace(strings.Join(lines, "\n"))
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		line := scanner.Text()
+		v := answerLineRe.FindStringSubmatch(line)
+		if v == nil {
+			if key.Day == 0 && strings.TrimSpace(line) != "" {
+				return nil, errors.Errorf("line %d: answer expected", lineno)
+			}
+			lines = append(lines, line)
+			continue
+		}
+
+		flush()
+
+		day, _ := strconv.Atoi(v[1])
+		part, _ := strconv.Atoi(v[2])
+		key = AnswerKey{Day: day, Part: part}
+		if _, dup := m[key]; dup {
+			return nil, errors.Errorf("line %d: duplicate answer for %v", lineno, key)
+		}
+		lines = append(lines[:0], v[3])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	flush()
+
+	return m, nil
+}
+
+// LoadAnswers reads answers from the file fn.
+func LoadAnswers(fn string) (Answers, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	a, err := ParseAnswers(f)
+	return a, errors.Wrapf(err, "parse answers %s", fn)
+}
+
+// Day returns the keys of answers for day in part order.
+func (a Answers) Day(day int) []AnswerKey {
+	var v []AnswerKey
+	for k := range a {
+		if k.Day == day {
+			v = append(v, k)
+		}
+	}
+	sort.Slice(v, func(i, j int) bool { return v[i].Part < v[j].Part })
+	return v
+}
+
+// CheckResult is the result of checking an answer.
+type CheckResult struct {
+	Key       AnswerKey
+	Got, Want string
+
+	HaveGot, HaveWant bool
+}
+
+// Status reports the outcome of the check.
+func (r CheckResult) Status() string {
+	switch {
+	case !r.HaveWant:
+		return "unchecked"
+	case !r.HaveGot:
+		return "MISSING"
+	case r.Got != r.Want:
+		return "FAIL"
+	}
+	return "ok"
+}
+
+// Failed reports if the answer was wrong or missing.
+func (r CheckResult) Failed() bool {
+	return r.HaveWant && (!r.HaveGot || r.Got != r.Want)
+}
+
+// CheckDay compares answers got for day with those wanted.
+func CheckDay(day int, got, want Answers) []CheckResult {
+	keys := want.Day(day)
+	for _, k := range got.Day(day) {
+		if _, ok := want[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Part < keys[j].Part })
+
+	var res []CheckResult
+	for _, k := range keys {
+		r := CheckResult{Key: k}
+		r.Got, r.HaveGot = got[k]
+		r.Want, r.HaveWant = want[k]
+		res = append(res, r)
+	}
+	return res
+}
+
+// WriteCheckTable writes results to w as a table,
+// and reports the number of failed checks.
+func WriteCheckTable(w io.Writer, results []CheckResult) (nfail int, err error) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "puzzle\tstatus\tdetail")
+	for _, r := range results {
+		var detail string
+		if r.Failed() {
+			nfail++
+			if r.HaveGot {
+				detail = fmt.Sprintf("got %q, want %q", r.Got, r.Want)
+			} else {
+				detail = fmt.Sprintf("want %q", r.Want)
+			}
+		}
+		fmt.Fprintf(tw, "%v\t%s", r.Key, r.Status())
+		if detail != "" {
+			fmt.Fprintf(tw, "\t%s", detail)
+		}
+		fmt.Fprintln(tw)
+	}
+	return nfail, tw.Flush()
+}
+
+// checkPuzzles runs puzzles using up to jobs goroutines, comparing their answers with
+// those in the answers file fn, and returns the exit code.
+func checkPuzzles(puzzles []puzzle, jobs int, fn string) (exitCode int) {
+	want, err := LoadAnswers(fn)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var results []CheckResult
+	runPuzzles(puzzles, jobs, func(r *puzzleRun) {
+		if r.err != nil {
+			log.Printf("puzzle %d failed: %v", r.n, r.err)
+		}
+
+		results = append(results, CheckDay(r.n, r.rep.Answers(), want)...)
+	})
+
+	nfail, err := WriteCheckTable(os.Stdout, results)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if nfail != 0 {
+		fmt.Printf("%d of %d checks failed\n", nfail, len(results))
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseAnswers(t *testing.T) {
+	src := `1/1: 486
+1/2: cvqlb
+10/1:
+#..#
+####
+10/2: 3
+`
+	a, err := ParseAnswers(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Answers{
+		{1, 1}:  "486",
+		{1, 2}:  "cvqlb",
+		{10, 1}: "#..#\n####",
+		{10, 2}: "3",
+	}
+	if len(a) != len(want) {
+		t.Fatalf("got %d answers; want %d", len(a), len(want))
+	}
+	for k, v := range want {
+		if a[k] != v {
+			t.Errorf("%v: got %q; want %q", k, a[k], v)
+		}
+	}
+
+	if _, err := ParseAnswers(strings.NewReader("junk\n1/1: 2\n")); err == nil {
+		t.Error("leading junk accepted")
+	}
+	if _, err := ParseAnswers(strings.NewReader("1/1: 2\n1/1: 3\n")); err == nil {
+		t.Error("duplicate answer accepted")
+	}
+}
+
+func TestCheckDay(t *testing.T) {
+	got := Answers{{5, 1}: "10", {5, 2}: "11", {5, 3}: "12"}
+	want := Answers{{5, 1}: "10", {5, 2}: "12", {5, 4}: "13", {6, 1}: "1"}
+
+	var status []string
+	for _, r := range CheckDay(5, got, want) {
+		status = append(status, r.Key.String()+" "+r.Status())
+	}
+
+	g := strings.Join(status, ", ")
+	w := "5/1 ok, 5/2 FAIL, 5/3 unchecked, 5/4 MISSING"
+	if g != w {
+		t.Errorf("got %s; want %s", g, w)
+	}
+}
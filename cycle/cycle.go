@@ -0,0 +1,134 @@
+// Package cycle detects cycles of deterministic simulations.
+//
+// A simulation stepping from a state to the next one by a deterministic
+// function eventually repeats a state if it has finitely many states.
+// From then on it repeats the same cycle of states forever.
+package cycle
+
+// Cycle describes the sequence of states x0, x1 = f(x0), x2 = f(x1)...
+// of a simulation, where x[Tail] is the first state repeated,
+// and x[i+Period] == x[i] for all i >= Tail.
+type Cycle struct {
+	Tail   int // number of states before the cycle
+	Period int // length of the cycle
+}
+
+// Index returns the index of the first state equal to state n.
+// It is less than Tail+Period, so that simulations can
+// be run only that far to find state n.
+func (c Cycle) Index(n int) int {
+	if n < c.Tail {
+		return n
+	}
+	return c.Tail + (n-c.Tail)%c.Period
+}
+
+// Floyd finds the cycle of f starting at x0
+// with Floyd's tortoise and hare algorithm.
+// It keeps only three states at a time.
+func Floyd[S comparable](x0 S, f func(S) S) Cycle {
+	return FloydFunc(x0, f, func(a, b S) bool { return a == b })
+}
+
+// FloydFunc is like Floyd, but compares states using equal.
+func FloydFunc[S any](x0 S, f func(S) S, equal func(a, b S) bool) Cycle {
+	// find a multiple of the period
+	tortoise, hare := f(x0), f(f(x0))
+	for !equal(tortoise, hare) {
+		tortoise, hare = f(tortoise), f(f(hare))
+	}
+
+	// the distance of the tortoise and the hare is a multiple of
+	// the period, they meet when the tortoise reaches the cycle
+	var c Cycle
+	tortoise = x0
+	for !equal(tortoise, hare) {
+		tortoise, hare = f(tortoise), f(hare)
+		c.Tail++
+	}
+
+	c.Period = 1
+	for hare = f(tortoise); !equal(tortoise, hare); hare = f(hare) {
+		c.Period++
+	}
+	return c
+}
+
+// Brent finds the cycle of f starting at x0 with Brent's algorithm.
+// It keeps only three states at a time, and usually calls f
+// fewer times than Floyd.
+func Brent[S comparable](x0 S, f func(S) S) Cycle {
+	return BrentFunc(x0, f, func(a, b S) bool { return a == b })
+}
+
+// BrentFunc is like Brent, but compares states using equal.
+func BrentFunc[S any](x0 S, f func(S) S, equal func(a, b S) bool) Cycle {
+	// find the period by searching in successive powers of two
+	c := Cycle{Period: 1}
+	power := 1
+	tortoise, hare := x0, f(x0)
+	for !equal(tortoise, hare) {
+		if power == c.Period {
+			tortoise = hare
+			power *= 2
+			c.Period = 0
+		}
+		hare = f(hare)
+		c.Period++
+	}
+
+	// find the start of the cycle with the hare
+	// a period ahead of the tortoise
+	tortoise, hare = x0, x0
+	for i := 0; i < c.Period; i++ {
+		hare = f(hare)
+	}
+	for !equal(tortoise, hare) {
+		tortoise, hare = f(tortoise), f(hare)
+		c.Tail++
+	}
+	return c
+}
+
+// History detects cycles by recording keys of visited states.
+// It works with simulations updating their state in place.
+type History[K comparable] struct {
+	seen map[K]int // index of states
+}
+
+// NewHistory returns an empty history.
+func NewHistory[K comparable]() *History[K] {
+	return &History[K]{seen: make(map[K]int)}
+}
+
+// Len returns the number of states recorded.
+func (h *History[K]) Len() int {
+	return len(h.seen)
+}
+
+// Add records the key of the next state, and
+// reports the cycle if it has been seen before.
+func (h *History[K]) Add(key K) (Cycle, bool) {
+	if i, ok := h.seen[key]; ok {
+		return Cycle{Tail: i, Period: len(h.seen) - i}, true
+	}
+	h.seen[key] = len(h.seen)
+	return Cycle{}, false
+}
+
+// Detect finds the cycle of a simulation by recording
+// the keys of its states. Key returns the key of the
+// current state, and step advances it to the next state.
+// It reports false if no state repeats in limit steps.
+func Detect[K comparable](key func() K, step func(), limit int) (Cycle, bool) {
+	h := NewHistory[K]()
+	for i := 0; ; i++ {
+		if c, ok := h.Add(key()); ok {
+			return c, true
+		}
+		if i == limit {
+			return Cycle{}, false
+		}
+		step()
+	}
+}
@@ -0,0 +1,89 @@
+package cycle
+
+import "testing"
+
+// rho returns a step function with a tail of tail
+// states followed by a cycle of period states.
+func rho(tail, period int) func(int) int {
+	return func(x int) int {
+		x++
+		if x == tail+period {
+			x = tail
+		}
+		return x
+	}
+}
+
+func TestCycle(t *testing.T) {
+	tests := []Cycle{
+		{0, 1},
+		{1, 1},
+		{0, 7},
+		{5, 1},
+		{10, 13},
+		{1000, 1},
+		{3, 1024},
+	}
+
+	for _, want := range tests {
+		f := rho(want.Tail, want.Period)
+		if got := Floyd(0, f); got != want {
+			t.Errorf("Floyd: got %+v; want %+v", got, want)
+		}
+		if got := Brent(0, f); got != want {
+			t.Errorf("Brent: got %+v; want %+v", got, want)
+		}
+
+		x := 0
+		got, ok := Detect(func() int { return x }, func() { x = f(x) }, 1e6)
+		if !ok || got != want {
+			t.Errorf("Detect: got %+v %v; want %+v", got, ok, want)
+		}
+	}
+}
+
+func TestDetectLimit(t *testing.T) {
+	x := 0
+	step := func() { x++ }
+	if _, ok := Detect(func() int { return x }, step, 100); ok {
+		t.Error("Detect found a cycle of an increasing sequence")
+	}
+	if x != 100 {
+		t.Errorf("got %d steps; want 100", x)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	c := Cycle{Tail: 3, Period: 4}
+	f := rho(c.Tail, c.Period)
+
+	x := 0
+	var seq []int
+	for n := 0; n < 50; n++ {
+		seq = append(seq, x)
+		if i := c.Index(n); seq[i] != x || i >= c.Tail+c.Period {
+			t.Fatalf("index %d of state %d: %d", i, n, seq[i])
+		}
+		x = f(x)
+	}
+}
+
+func TestFuncVariants(t *testing.T) {
+	// states as slices, that are not comparable
+	f := func(v []int) []int {
+		return []int{(v[0]*v[0] + 1) % 255}
+	}
+	equal := func(a, b []int) bool { return a[0] == b[0] }
+
+	x := []int{3}
+	want, ok := Detect(func() int { return x[0] }, func() { x = f(x) }, 1000)
+	if !ok {
+		t.Fatal("no cycle")
+	}
+	if got := FloydFunc([]int{3}, f, equal); got != want {
+		t.Errorf("FloydFunc: got %+v; want %+v", got, want)
+	}
+	if got := BrentFunc([]int{3}, f, equal); got != want {
+		t.Errorf("BrentFunc: got %+v; want %+v", got, want)
+	}
+}
@@ -0,0 +1,458 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/tajtiattila/aoc18/wristdev"
+)
+
+// debugMain runs the debug command with args, and returns the exit code.
+func debugMain(args []string) int {
+	fs := flag.NewFlagSet("debug", flag.ExitOnError)
+	history := fs.Int("history", 1000, "number of reversible `steps`")
+	maxstep := fs.Int("maxstep", 1e9, "maximum number of `steps` run by a command")
+	regs := fs.String("r", "", "initial register `values`, separated by commas")
+	ext := fs.Bool("ext", false, "use the extended instruction set with div, mod, shl, shr, out and halt")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc18 debug [flags] file")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	set := wristdev.Standard
+	if *ext {
+		set = wristdev.Extended
+	}
+	p, err := loadDebugProgram(fs.Arg(0), set)
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+
+	var values []int
+	if *regs != "" {
+		for _, f := range strings.Split(*regs, ",") {
+			v, err := strconv.Atoi(strings.TrimSpace(f))
+			if err != nil {
+				log.Printf("invalid register value %q", f)
+				return 2
+			}
+			values = append(values, v)
+		}
+		if len(values) > p.Arch.NReg {
+			log.Printf("too many register values: %d for %d registers", len(values), p.Arch.NReg)
+			return 2
+		}
+	}
+
+	d := wristdev.NewDebugger(p.Arch.State(values...), p.Inst, *history)
+	sess := &debugSession{p: p, d: d, w: os.Stdout, maxstep: *maxstep}
+	if err := sess.repl(os.Stdin); err != nil {
+		log.Print(err)
+		return 1
+	}
+	return 0
+}
+
+// loadDebugProgram assembles the program in the file fn using set.
+func loadDebugProgram(fn string, set *wristdev.InstructionSet) (*wristdev.Program, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p, err := set.Assemble(f)
+	return p, errors.Wrap(err, fn)
+}
+
+// debugSession executes debugger commands.
+type debugSession struct {
+	p *wristdev.Program
+	d *wristdev.Debugger
+	w io.Writer
+
+	maxstep int // steps per command
+}
+
+type debugCmd struct {
+	names []string
+	args  string
+	help  string
+
+	run func(s *debugSession, args []string) error
+}
+
+var debugCmds []debugCmd
+
+func init() {
+	debugCmds = []debugCmd{
+		{[]string{"step", "s"}, "[n]", "execute n instructions", (*debugSession).step},
+		{[]string{"next", "n"}, "", "execute until the next instruction", (*debugSession).next},
+		{[]string{"continue", "c"}, "", "execute until a breakpoint or watchpoint", (*debugSession).cont},
+		{[]string{"reverse", "rs"}, "[n]", "reverse n steps", (*debugSession).reverse},
+		{[]string{"break", "b"}, "addr [if cond]", "set breakpoint, eg. \"b 3 if r1 > 5\"", (*debugSession).setBreak},
+		{[]string{"delete", "d"}, "addr", "delete breakpoint", (*debugSession).deleteBreak},
+		{[]string{"watch", "w"}, "rN | cond", "watch writes of register or condition", (*debugSession).watch},
+		{[]string{"unwatch"}, "rN", "delete watchpoints of register", (*debugSession).unwatch},
+		{[]string{"info", "i"}, "", "show breakpoints and watchpoints", (*debugSession).info},
+		{[]string{"regs", "r"}, "", "show registers", (*debugSession).regs},
+		{[]string{"set"}, "rN value", "set register", (*debugSession).set},
+		{[]string{"list", "l"}, "[addr]", "list instructions", (*debugSession).list},
+		{[]string{"profile", "prof"}, "[n]", "show the n most executed instructions", (*debugSession).profile},
+		{[]string{"help", "h"}, "", "show commands", (*debugSession).help},
+	}
+}
+
+// repl reads and executes commands from r until EOF or quit.
+// An empty line repeats the last command.
+func (s *debugSession) repl(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	var last string
+	for {
+		fmt.Fprint(s.w, "(wd) ")
+		if !scanner.Scan() {
+			fmt.Fprintln(s.w)
+			return scanner.Err()
+		}
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			line = last
+		}
+		last = line
+
+		quit, err := s.exec(line)
+		if err != nil {
+			fmt.Fprintln(s.w, "error:", err)
+		}
+		if quit {
+			return nil
+		}
+	}
+}
+
+// exec executes the command line, and reports if the session should end.
+func (s *debugSession) exec(line string) (quit bool, err error) {
+	f := strings.Fields(line)
+	if len(f) == 0 {
+		return false, nil
+	}
+	if f[0] == "quit" || f[0] == "q" {
+		return true, nil
+	}
+
+	for _, c := range debugCmds {
+		for _, n := range c.names {
+			if n == f[0] {
+				return false, c.run(s, f[1:])
+			}
+		}
+	}
+	return false, errors.Errorf("unknown command %q, try help", f[0])
+}
+
+// count parses an optional count argument.
+func count(args []string, def int) (int, error) {
+	if len(args) == 0 {
+		return def, nil
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 0 {
+		return 0, errors.Errorf("invalid count %q", args[0])
+	}
+	return n, nil
+}
+
+// parseReg parses a register argument such as "r3".
+func (s *debugSession) parseReg(a string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(a, "r"))
+	if err != nil || !strings.HasPrefix(a, "r") || n < 0 || n >= s.p.Arch.NReg {
+		return 0, errors.Errorf("invalid register %q", a)
+	}
+	return n, nil
+}
+
+func (s *debugSession) parseAddr(a string) (int, error) {
+	n, err := strconv.Atoi(a)
+	if err != nil || n < 0 || n >= len(s.p.Inst) {
+		return 0, errors.Errorf("invalid address %q", a)
+	}
+	return n, nil
+}
+
+func (s *debugSession) step(args []string) error {
+	n, err := count(args, 1)
+	if err != nil {
+		return err
+	}
+	s.stopped(s.d.Step(n))
+	return nil
+}
+
+func (s *debugSession) next(args []string) error {
+	s.stopped(s.d.Next(s.maxstep))
+	return nil
+}
+
+func (s *debugSession) cont(args []string) error {
+	s.stopped(s.d.Continue(s.maxstep))
+	return nil
+}
+
+func (s *debugSession) reverse(args []string) error {
+	n, err := count(args, 1)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < n; i++ {
+		if !s.d.Back() {
+			fmt.Fprintln(s.w, "history exhausted")
+			break
+		}
+	}
+	s.where()
+	return nil
+}
+
+// stopped shows the result of a command executing instructions.
+func (s *debugSession) stopped(ev wristdev.Event) {
+	switch ev.Reason {
+	case wristdev.StopBreak:
+		fmt.Fprintf(s.w, "breakpoint at %d", ev.Break.Addr)
+		if ev.Break.Cond != nil {
+			fmt.Fprintf(s.w, " if %v", *ev.Break.Cond)
+		}
+		fmt.Fprintf(s.w, ", hit %d times\n", ev.Break.Hits)
+	case wristdev.StopWatch:
+		fmt.Fprintf(s.w, "watchpoint r%d: %d -> %d\n", ev.Watch.Reg, ev.Old, ev.New)
+	case wristdev.StopHalt:
+		fmt.Fprintf(s.w, "halted after %d steps\n", s.d.Steps)
+	}
+	s.where()
+}
+
+// where shows the state and the current instruction.
+func (s *debugSession) where() {
+	fmt.Fprintf(s.w, "step %d: %v\n", s.d.Steps, s.d.State)
+	if out := s.d.State.Output; len(out) != 0 {
+		fmt.Fprintf(s.w, "output: %v\n", out)
+	}
+	if !s.d.Halted() {
+		s.listRange(*s.d.State.IP, *s.d.State.IP+1)
+	}
+}
+
+func (s *debugSession) setBreak(args []string) error {
+	if len(args) == 0 {
+		return errors.New("break needs an address")
+	}
+	addr, err := s.parseAddr(args[0])
+	if err != nil {
+		return err
+	}
+
+	var cond *wristdev.Condition
+	if len(args) > 1 {
+		if args[1] != "if" || len(args) == 2 {
+			return errors.New(`break condition must follow "if"`)
+		}
+		c, err := wristdev.ParseCondition(strings.Join(args[2:], " "))
+		if err != nil {
+			return err
+		}
+		cond = &c
+	}
+
+	s.d.Break(addr, cond)
+	return nil
+}
+
+func (s *debugSession) deleteBreak(args []string) error {
+	if len(args) != 1 {
+		return errors.New("delete needs an address")
+	}
+	addr, err := s.parseAddr(args[0])
+	if err != nil {
+		return err
+	}
+	if !s.d.ClearBreak(addr) {
+		return errors.Errorf("no breakpoint at %d", addr)
+	}
+	return nil
+}
+
+func (s *debugSession) watch(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("watch needs a register or condition")
+	case 1:
+		reg, err := s.parseReg(args[0])
+		if err != nil {
+			return err
+		}
+		s.d.WatchWrites(reg)
+		return nil
+	}
+
+	c, err := wristdev.ParseCondition(strings.Join(args, " "))
+	if err != nil {
+		return err
+	}
+	if _, err := s.parseReg(fmt.Sprintf("r%d", c.Reg)); err != nil {
+		return err
+	}
+	s.d.WatchValue(c)
+	return nil
+}
+
+func (s *debugSession) unwatch(args []string) error {
+	if len(args) != 1 {
+		return errors.New("unwatch needs a register")
+	}
+	reg, err := s.parseReg(args[0])
+	if err != nil {
+		return err
+	}
+	if s.d.ClearWatch(reg) == 0 {
+		return errors.Errorf("no watchpoint on r%d", reg)
+	}
+	return nil
+}
+
+func (s *debugSession) info(args []string) error {
+	for _, bp := range s.d.Breakpoints() {
+		fmt.Fprintf(s.w, "break %d", bp.Addr)
+		if bp.Cond != nil {
+			fmt.Fprintf(s.w, " if %v", *bp.Cond)
+		}
+		fmt.Fprintf(s.w, ", hit %d times\n", bp.Hits)
+	}
+	for _, wp := range s.d.Watchpoints() {
+		if wp.Cond != nil {
+			fmt.Fprintf(s.w, "watch %v", *wp.Cond)
+		} else {
+			fmt.Fprintf(s.w, "watch r%d", wp.Reg)
+		}
+		fmt.Fprintf(s.w, ", hit %d times\n", wp.Hits)
+	}
+	return nil
+}
+
+func (s *debugSession) regs(args []string) error {
+	s.where()
+	return nil
+}
+
+func (s *debugSession) set(args []string) error {
+	if len(args) != 2 {
+		return errors.New("set needs a register and a value")
+	}
+	reg, err := s.parseReg(args[0])
+	if err != nil {
+		return err
+	}
+	v, err := strconv.Atoi(args[1])
+	if err != nil {
+		return errors.Errorf("invalid value %q", args[1])
+	}
+	s.d.State.R[reg] = v
+	return nil
+}
+
+func (s *debugSession) list(args []string) error {
+	at := *s.d.State.IP
+	if len(args) != 0 {
+		var err error
+		if at, err = s.parseAddr(args[0]); err != nil {
+			return err
+		}
+	}
+	s.listRange(at-5, at+6)
+	return nil
+}
+
+// listRange lists instructions in [lo, hi).
+// The current instruction is marked with '>', breakpoints with '*'.
+func (s *debugSession) listRange(lo, hi int) {
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > len(s.p.Inst) {
+		hi = len(s.p.Inst)
+	}
+
+	breaks := make(map[int]bool)
+	for _, bp := range s.d.Breakpoints() {
+		breaks[bp.Addr] = true
+	}
+
+	for i := lo; i < hi; i++ {
+		mark := []byte("  ")
+		if i == *s.d.State.IP {
+			mark[0] = '>'
+		}
+		if breaks[i] {
+			mark[1] = '*'
+		}
+		fmt.Fprintf(s.w, "%s%3d  %v", mark, i, s.p.Inst[i])
+		if c := s.p.Comments[i]; c != "" {
+			fmt.Fprintf(s.w, " ; %s", c)
+		}
+		fmt.Fprintln(s.w)
+	}
+}
+
+func (s *debugSession) profile(args []string) error {
+	n, err := count(args, 10)
+	if err != nil {
+		return err
+	}
+
+	addrs := make([]int, len(s.p.Inst))
+	for i := range addrs {
+		addrs[i] = i
+	}
+	hits := s.d.Hits
+	sort.SliceStable(addrs, func(i, j int) bool {
+		return hits[addrs[i]] > hits[addrs[j]]
+	})
+	if n < len(addrs) {
+		addrs = addrs[:n]
+	}
+
+	tw := tabwriter.NewWriter(s.w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "addr\thits\t%\t")
+	for _, a := range addrs {
+		if hits[a] == 0 {
+			break
+		}
+		pct := 100 * float64(hits[a]) / float64(s.d.Steps)
+		fmt.Fprintf(tw, "%d\t%d\t%.1f\t  %v\n", a, hits[a], pct, s.p.Inst[a])
+	}
+	return tw.Flush()
+}
+
+func (s *debugSession) help(args []string) error {
+	tw := tabwriter.NewWriter(s.w, 0, 8, 2, ' ', 0)
+	for _, c := range debugCmds {
+		fmt.Fprintf(tw, "%s %s\t%s\n", strings.Join(c.names, "|"), c.args, c.help)
+	}
+	fmt.Fprintln(tw, "quit|q\tend session")
+	return tw.Flush()
+}
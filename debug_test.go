@@ -0,0 +1,76 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tajtiattila/aoc18/wristdev"
+)
+
+const debugTestSource = `#ip 5
+seti 0 0 0
+loop:
+addi 0 1 0 ; count
+gtri 0 9 1
+addr 1 5 5
+jmp loop
+`
+
+func newTestSession(t *testing.T) (*debugSession, *strings.Builder) {
+	p, err := wristdev.Assemble(strings.NewReader(debugTestSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sb strings.Builder
+	return &debugSession{
+		p:       p,
+		d:       wristdev.NewDebugger(p.Arch.State(), p.Inst, 100),
+		w:       &sb,
+		maxstep: 1e6,
+	}, &sb
+}
+
+func TestDebugSession(t *testing.T) {
+	s, out := newTestSession(t)
+
+	tests := []struct {
+		cmd  string
+		want string
+	}{
+		{"b 2 if r0 == 5", ""},
+		{"c", "breakpoint at 2 if r0 == 5, hit 1 times\nstep 18: ip=2 [5 0 0 0 0 2]\n>*  2  gtri 0 9 1\n"},
+		{"rs 2", "step 16: ip=4 [4 0 0 0 0 4]\n>   4  seti 0 0 5 ; jmp loop\n"},
+		{"d 2", ""},
+		{"watch r0 >= 8", ""},
+		{"c", "watchpoint r0: 7 -> 8\nstep 30: ip=2 [8 0 0 0 0 2]\n>   2  gtri 0 9 1\n"},
+		{"unwatch r0", ""},
+		{"c", "halted after 40 steps\nstep 40: ip=5 [10 1 0 0 0 5]\n"},
+		{"set r0 3", ""},
+		{"l 1", "    0  seti 0 0 0\n    1  addi 0 1 0 ; count\n    2  gtri 0 9 1\n    3  addr 1 5 5\n    4  seti 0 0 5 ; jmp loop\n"},
+	}
+	for _, tt := range tests {
+		out.Reset()
+		if _, err := s.exec(tt.cmd); err != nil {
+			t.Fatalf("%s: %v", tt.cmd, err)
+		}
+		if got := out.String(); got != tt.want {
+			t.Fatalf("%s: got\n%s\nwant\n%s", tt.cmd, got, tt.want)
+		}
+	}
+
+	out.Reset()
+	s.exec("profile 1")
+	if got := out.String(); !strings.Contains(got, "1    10  25.0  addi 0 1 0") {
+		t.Errorf("got profile:\n%s", got)
+	}
+
+	for _, cmd := range []string{"x", "b", "b 99", "b 1 when r1 > 2", "w r9", "set r0"} {
+		if _, err := s.exec(cmd); err == nil {
+			t.Errorf("%s: no error", cmd)
+		}
+	}
+
+	if quit, _ := s.exec("q"); !quit {
+		t.Error("quit failed")
+	}
+}
@@ -180,23 +180,16 @@ func (m *Map) Tile(x, y int) Tile {
 }
 
 func (m *Map) MaxDoors() int {
-
-	_, maxDist := pathfind.Flood(pt(0, 0), pathfind.Space{
-		Adjacent: m.pathfindAdjacents,
-	})
-
+	_, maxDist := m.flood()
 	return maxDist
 }
 
 func (m *Map) FarRooms(minDist int) int {
-
-	fm, _ := pathfind.Flood(pt(0, 0), pathfind.Space{
-		Adjacent: m.pathfindAdjacents,
-	})
+	dist, _ := m.flood()
 
 	n := 0
-	for _, dist := range fm {
-		if dist >= minDist {
+	for _, d := range dist {
+		if d != pathfind.NotReached && d >= minDist {
 			n++
 		}
 	}
@@ -204,24 +197,24 @@ func (m *Map) FarRooms(minDist int) int {
 	return n
 }
 
-func (m *Map) pathfindAdjacents(pp pathfind.Place, dst []pathfind.Place) []pathfind.Place {
-	p := pp.(point)
-	x, y := p.x, p.y
-	t := m.p[m.ofs(int(x), int(y))]
-
-	if t&TileDoorN != 0 {
-		dst = append(dst, pt(x, y-1))
+// flood finds the distance of rooms from 0, 0, indexed like m.p.
+func (m *Map) flood() (dist []int, maxDist int) {
+	g := pathfind.Grid{
+		Dx: m.dx,
+		Dy: m.dy,
+		CanStep: func(i int, d pathfind.Dir) bool {
+			return m.p[i]&dirDoor[d] != 0
+		},
 	}
-	if t&TileDoorS != 0 {
-		dst = append(dst, pt(x, y+1))
-	}
-	if t&TileDoorW != 0 {
-		dst = append(dst, pt(x-1, y))
-	}
-	if t&TileDoorE != 0 {
-		dst = append(dst, pt(x+1, y))
-	}
-	return dst
+	return g.Flood(m.ofs(0, 0), nil)
+}
+
+// dirDoor maps pathfind directions to doors.
+var dirDoor = [...]Tile{
+	pathfind.North: TileDoorN,
+	pathfind.South: TileDoorS,
+	pathfind.West:  TileDoorW,
+	pathfind.East:  TileDoorE,
 }
 
 // bool canStep reports if one can go from
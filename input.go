@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// InputSource provides puzzle inputs.
+type InputSource interface {
+	// Open opens the input of puzzle n.
+	//
+	// It returns an error satisfying os.IsNotExist
+	// if the source has no input for n.
+	Open(n int) (io.ReadCloser, error)
+
+	String() string // description for error messages
+}
+
+// InputResolver opens puzzle inputs from the first
+// of its sources having the input.
+type InputResolver struct {
+	Sources []InputSource
+}
+
+// Open opens the input of puzzle n.
+func (r *InputResolver) Open(n int) (io.ReadCloser, error) {
+	var tried []string
+	for _, src := range r.Sources {
+		rc, err := src.Open(n)
+		if err == nil {
+			return rc, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, errors.Wrapf(err, "puzzle input %02d from %v", n, src)
+		}
+		tried = append(tried, src.String())
+	}
+	return nil, errors.Errorf("puzzle input %02d not found in %s",
+		n, strings.Join(tried, ", "))
+}
+
+func inputFileName(n int) string {
+	return fmt.Sprintf("%02d.txt", n)
+}
+
+// DirSource reads puzzle inputs named like 01.txt from a directory.
+type DirSource string
+
+func (d DirSource) Open(n int) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(string(d), inputFileName(n)))
+}
+
+func (d DirSource) String() string { return string(d) }
+
+// CacheSource is a DirSource that can also store inputs
+// read from elsewhere, such as stdin.
+type CacheSource struct {
+	DirSource
+}
+
+// Store saves data as the input of puzzle n.
+func (c CacheSource) Store(n int, data []byte) error {
+	dir := string(c.DirSource)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(err, "create input cache")
+	}
+	fn := filepath.Join(dir, inputFileName(n))
+	return errors.Wrap(ioutil.WriteFile(fn, data, 0644), "store input in cache")
+}
+
+func (c CacheSource) String() string { return "cache " + string(c.DirSource) }
+
+// DefaultCacheDir returns the input cache directory
+// under the user's cache directory.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "aoc18"), nil
+}
+
+// ReaderSource serves the input of a single puzzle from r.
+//
+// The data is read on first use so it can be opened repeatedly.
+// If Cache is not nil, the data is stored in it.
+type ReaderSource struct {
+	N     int
+	Name  string
+	R     io.Reader
+	Cache *CacheSource
+
+	once sync.Once
+	data []byte
+	err  error
+}
+
+func (s *ReaderSource) Open(n int) (io.ReadCloser, error) {
+	if n != s.N {
+		return nil, os.ErrNotExist
+	}
+
+	s.once.Do(func() {
+		s.data, s.err = ioutil.ReadAll(s.R)
+		if s.err == nil && s.Cache != nil {
+			if err := s.Cache.Store(n, s.data); err != nil {
+				log.Println(err)
+			}
+		}
+	})
+
+	if s.err != nil {
+		return nil, s.err
+	}
+	return ioutil.NopCloser(bytes.NewReader(s.data)), nil
+}
+
+func (s *ReaderSource) String() string { return s.Name }
@@ -4,50 +4,78 @@ import (
 	"io"
 
 	"github.com/pkg/errors"
+	"github.com/tajtiattila/aoc18/cycle"
 )
 
 type Area struct {
+	ca *automaton
+
 	dx, dy int // true dimensions
 
+	border  int // width of the border around the area
 	start   int // start offset of 0,0 coordinate
 	vstride int // vertical stride
 
 	m []tile
 
 	last []tile
+
+	gen int // generations stepped
+
+	nbors  []int // neighbor offsets
+	counts []int // neighbor counts by tile
 }
 
+// tile is the index of a tile in the alphabet.
 type tile byte
 
-const (
-	tileopen = iota
-	tiletree
-	tileyard
-)
+// ParseArea parses an area from src with the Lumber rules.
+func ParseArea(src []string) (*Area, error) {
+	return Lumber.ParseArea(src)
+}
 
-const border = 1
+// ParseArea parses an area from src running rules r.
+// Lines in src must have equal length,
+// and consist of tiles from r.Alphabet.
+func (r *Rules) ParseArea(src []string) (*Area, error) {
+	ca, err := r.compile()
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid rules")
+	}
 
-func ParseArea(src []string) (*Area, error) {
 	if len(src) == 0 {
 		return nil, errors.New("empty area")
 	}
 
 	dx, dy := len(src[0]), len(src)
+	if dx == 0 {
+		return nil, errors.New("empty area")
+	}
 
+	border := ca.radius
 	vstride := dx + 2*border
 
 	n := (dy + 2*border) * vstride
 
 	a := &Area{
+		ca: ca,
+
 		dx: dx,
 		dy: dy,
 
+		border:  border,
 		start:   border * (vstride + 1),
 		vstride: vstride,
 
 		m: make([]tile, n),
 
 		last: make([]tile, n),
+
+		counts: make([]int, len(ca.alphabet)),
+	}
+
+	for _, d := range ca.nbors {
+		a.nbors = append(a.nbors, d[0]+d[1]*vstride)
 	}
 
 	for y, line := range src {
@@ -56,48 +84,89 @@ func ParseArea(src []string) (*Area, error) {
 		}
 
 		for x := 0; x < dx; x++ {
-			var t tile
-			switch line[x] {
-			case '.':
-				t = tileopen
-			case '|':
-				t = tiletree
-			case '#':
-				t = tileyard
-			default:
+			i := ca.index[line[x]]
+			if i < 0 {
 				return nil, errors.Errorf("line %d: invalid rune", y+1)
 			}
-			a.m[a.ofs(x, y)] = t
+			a.m[a.ofs(x, y)] = tile(i)
 		}
 	}
+	a.fillBorder()
 
 	return a, nil
 }
 
 func (a *Area) ofs(x, y int) int { return a.start + x + y*a.vstride }
 
-func (a *Area) nbors(ofs int, res []int) []int {
-	v := a.vstride
-	return append(res,
-		ofs-v-1, ofs-v, ofs-v+1,
-		ofs-1, ofs+1,
-		ofs+v-1, ofs+v, ofs+v+1)
-}
-
 func (a *Area) Step(n int) {
 	for i := 0; i < n; i++ {
 		a.stepone()
+		a.gen++
+	}
+}
+
+// Gen returns the number of generations stepped since parsing.
+func (a *Area) Gen() int {
+	return a.gen
+}
+
+// StepTo steps a to generation n. It records the states stepped
+// through, and once a state repeats, it jumps to generation n
+// by restoring the state of the cycle equal to it.
+//
+// It returns the cycle, with Tail being the generation it starts at,
+// and true if a repeat is found before generation n.
+func (a *Area) StepTo(n int) (cycle.Cycle, bool) {
+	if n < a.gen {
+		panic("lumbercoll: StepTo backwards")
+	}
+
+	base := a.gen
+	h := cycle.NewHistory[string]()
+	var states []string
+	for a.gen < n {
+		key := a.Key()
+		if c, ok := h.Add(key); ok {
+			a.restore(states[c.Index(n-base)])
+			a.gen = n
+			c.Tail += base
+			return c, true
+		}
+		states = append(states, key)
+
+		a.Step(1)
 	}
+	return cycle.Cycle{}, false
+}
+
+// restore restores acres of a from key.
+func (a *Area) restore(key string) {
+	for i := range a.m {
+		a.m[i] = tile(key[i])
+	}
+}
+
+// Key returns a string identifying the acres of a,
+// for finding repeating states.
+func (a *Area) Key() string {
+	return string(a.m)
 }
 
 func (a *Area) ResourceValue() int {
 	return a.TreeCount() * a.LumberyardCount()
 }
 
-func (a *Area) TreeCount() int       { return a.count(tiletree) }
-func (a *Area) LumberyardCount() int { return a.count(tileyard) }
+func (a *Area) TreeCount() int       { return a.Count('|') }
+func (a *Area) LumberyardCount() int { return a.Count('#') }
+
+// Count returns the number of tiles c in a.
+func (a *Area) Count(c byte) int {
+	i := a.ca.index[c]
+	if i < 0 {
+		return 0
+	}
+	t := tile(i)
 
-func (a *Area) count(t tile) int {
 	n := 0
 	line := a.start
 	for y := 0; y < a.dy; y++ {
@@ -120,18 +189,7 @@ func (a *Area) Dump(w io.Writer) error {
 		o := line
 		line += a.vstride
 		for x := 0; x < a.dx; x, o = x+1, o+1 {
-			var c byte
-			switch a.m[o] {
-			case tileopen:
-				c = '.'
-			case tiletree:
-				c = '|'
-			case tileyard:
-				c = '#'
-			default:
-				c = '?'
-			}
-			buf[x] = c
+			buf[x] = a.ca.alphabet[a.m[o]]
 		}
 		_, err := w.Write(buf)
 		if err != nil {
@@ -144,52 +202,51 @@ func (a *Area) Dump(w io.Writer) error {
 func (a *Area) stepone() {
 	copy(a.last, a.m)
 
-	var buf [8]int
-
 	line := a.start
 	for y := 0; y < a.dy; y++ {
 		o := line
 		line += a.vstride
 		for x := 0; x < a.dx; x, o = x+1, o+1 {
-			nbors := a.nbors(o, buf[:0])
-			switch a.m[o] {
-
-			case tileopen:
-				ntrees := 0
-				for _, no := range nbors {
-					if a.last[no] == tiletree {
-						ntrees++
-					}
-				}
-				if ntrees >= 3 {
-					a.m[o] = tiletree
-				}
+			trans := a.ca.trans[a.last[o]]
+			if len(trans) == 0 {
+				continue
+			}
 
-			case tiletree:
-				nyards := 0
-				for _, no := range nbors {
-					if a.last[no] == tileyard {
-						nyards++
-					}
-				}
-				if nyards >= 3 {
-					a.m[o] = tileyard
-				}
+			for i := range a.counts {
+				a.counts[i] = 0
+			}
+			for _, d := range a.nbors {
+				a.counts[a.last[o+d]]++
+			}
 
-			case tileyard:
-				ntrees, nyards := 0, 0
-				for _, no := range nbors {
-					switch a.last[no] {
-					case tiletree:
-						ntrees++
-					case tileyard:
-						nyards++
-					}
-				}
-				if ntrees == 0 || nyards == 0 {
-					a.m[o] = tileopen
+			for _, t := range trans {
+				if t.match(a.counts) {
+					a.m[o] = t.to
+					break
 				}
 			}
 		}
 	}
+
+	a.fillBorder()
+}
+
+// fillBorder fills the border around a according to its boundary.
+// Dead borders hold the default tile from the start.
+func (a *Area) fillBorder() {
+	b := a.ca.boundary
+	if b == Dead {
+		return
+	}
+
+	for y := -a.border; y < a.dy+a.border; y++ {
+		inside := y >= 0 && y < a.dy
+		sy := b.fold(y, a.dy)
+		for x := -a.border; x < a.dx+a.border; x++ {
+			if inside && x == 0 {
+				x = a.dx // skip tiles within the area
+			}
+			a.m[a.ofs(x, y)] = a.m[a.ofs(b.fold(x, a.dx), sy)]
+		}
+	}
 }
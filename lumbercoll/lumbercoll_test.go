@@ -4,8 +4,22 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+
+	"github.com/tajtiattila/aoc18/cycle"
 )
 
+const exampleArea = `
+.#.#...|#.
+.....#|##|
+.|..|...#.
+..|#.....#
+#.#|||#|#|
+...#.||...
+.|....|...
+||...#|.#|
+|.||||..|.
+...#.|..|.`
+
 func TestLumberColl(t *testing.T) {
 	type test struct {
 		nsim   int
@@ -22,17 +36,7 @@ func TestLumberColl(t *testing.T) {
 			nsim:   10,
 			wanttc: 37,
 			wantlc: 31,
-			start: `
-.#.#...|#.
-.....#|##|
-.|..|...#.
-..|#.....#
-#.#|||#|#|
-...#.||...
-.|....|...
-||...#|.#|
-|.||||..|.
-...#.|..|.`,
+			start:  exampleArea,
 
 			state: []string{
 				`
@@ -183,3 +187,159 @@ func TestLumberColl(t *testing.T) {
 		}
 	}
 }
+
+func TestStepTo(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(exampleArea), "\n")
+
+	// the example area is all trees from generation 18
+	tests := []struct {
+		first, n int
+
+		want cycle.Cycle
+		ok   bool
+	}{
+		{0, 0, cycle.Cycle{}, false},
+		{0, 10, cycle.Cycle{}, false},
+		{0, 100, cycle.Cycle{Tail: 18, Period: 1}, true},
+		{0, 1000, cycle.Cycle{Tail: 18, Period: 1}, true},
+		{7, 7, cycle.Cycle{}, false},
+		{7, 10, cycle.Cycle{}, false},
+		{7, 100, cycle.Cycle{Tail: 18, Period: 1}, true},
+		{7, 1000, cycle.Cycle{Tail: 18, Period: 1}, true},
+	}
+
+	for _, tt := range tests {
+		want, err := ParseArea(lines)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want.Step(tt.n)
+
+		a, _ := ParseArea(lines)
+		a.Step(tt.first)
+		c, ok := a.StepTo(tt.n)
+		if c != tt.want || ok != tt.ok {
+			t.Errorf("StepTo(%d) from %d: got %+v %v; want %+v %v", tt.n, tt.first, c, ok, tt.want, tt.ok)
+		}
+		if a.Gen() != tt.n || a.Key() != want.Key() {
+			t.Errorf("StepTo(%d) from %d: got generation %d, or state differs", tt.n, tt.first, a.Gen())
+		}
+	}
+}
+
+func TestLife(t *testing.T) {
+	life, err := LifeRules("B3/S23")
+	if err != nil {
+		t.Fatal(err)
+	}
+	torus := *life
+	torus.Boundary = Wrap
+
+	tests := []struct {
+		rules  *Rules
+		src    string
+		period int
+	}{
+		{life, `
+.....
+..#..
+..#..
+..#..
+.....`, 2}, // blinker
+		{&torus, `
+.#......
+..#.....
+###.....
+........
+........
+........
+........
+........`, 32}, // glider
+	}
+
+	for _, tt := range tests {
+		a, err := tt.rules.ParseArea(strings.Split(strings.TrimSpace(tt.src), "\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := a.Count('#')
+		c, ok := a.StepTo(1000)
+		if !ok || c.Tail != 0 || c.Period != tt.period {
+			t.Errorf("got cycle %+v %v, want period %d", c, ok, tt.period)
+		}
+		if got := a.Count('#'); got != n {
+			t.Errorf("got %d live cells, want %d", got, n)
+		}
+	}
+}
+
+func TestRules(t *testing.T) {
+	spread := func(nb Neighborhood, radius int, b Boundary) *Rules {
+		return &Rules{
+			Alphabet:     ".#",
+			Neighborhood: nb,
+			Radius:       radius,
+			Boundary:     b,
+			Transitions: []Transition{
+				{From: '.', To: '#', If: []Count{{'#', 3, -1}}},
+			},
+		}
+	}
+
+	tests := []struct {
+		rules  *Rules
+		nnbors int
+		src    string
+		want   string
+	}{
+		{spread(Moore, 1, Dead), 8, "#...", "#..."},
+		{spread(Moore, 1, Wrap), 8, "#...", "##.#"},
+		{spread(Moore, 1, Reflect), 8, "#...", "##.."},
+		{spread(Moore, 2, Dead), 24, "##.#.", "####."},
+		{spread(VonNeumann, 1, Wrap), 4, "#...", "#..."},
+		{spread(VonNeumann, 2, Dead), 12, "##.#.", "####."},
+	}
+
+	for _, tt := range tests {
+		a, err := tt.rules.ParseArea([]string{tt.src})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(a.nbors) != tt.nnbors {
+			t.Errorf("got %d neighbors, want %d", len(a.nbors), tt.nnbors)
+		}
+		a.Step(1)
+		var buf bytes.Buffer
+		a.Dump(&buf)
+		if got := strings.TrimSpace(buf.String()); got != tt.want {
+			t.Errorf("%+v %q: got %q, want %q", tt.rules, tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestRulesInvalid(t *testing.T) {
+	tests := []Rules{
+		{},
+		{Alphabet: ".#."},
+		{Alphabet: ".#", Radius: -1},
+		{Alphabet: ".#", Neighborhood: 2},
+		{Alphabet: ".#", Boundary: 3},
+		{Alphabet: ".#", Transitions: []Transition{{From: '.', To: '|'}}},
+		{Alphabet: ".#", Transitions: []Transition{{From: '.', To: '#', If: []Count{{'|', 1, 1}}}}},
+	}
+	for _, r := range tests {
+		if _, err := r.ParseArea([]string{".."}); err == nil {
+			t.Errorf("%+v: no error", r)
+		}
+	}
+
+	for _, s := range []string{"B3", "B3/X23", "B39/S23", "S23/S3"} {
+		if _, err := LifeRules(s); err == nil {
+			t.Errorf("LifeRules(%q): no error", s)
+		}
+	}
+
+	if _, err := ParseArea([]string{".|x"}); err == nil {
+		t.Error("invalid tile accepted")
+	}
+}
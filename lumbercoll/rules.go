@@ -0,0 +1,271 @@
+package lumbercoll
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Rules define a cellular automaton running on areas.
+type Rules struct {
+	// Alphabet holds the tiles as they appear in area sources.
+	// The first tile is the default one, used also outside
+	// areas with the Dead boundary.
+	Alphabet string
+
+	Neighborhood Neighborhood
+	Radius       int // radius of the neighborhood, 0 means 1
+
+	Boundary Boundary
+
+	// Transitions are tried in order for each tile,
+	// and the first one matching is applied.
+	// Tiles without matching transitions remain unchanged.
+	Transitions []Transition
+}
+
+// Neighborhood is the shape of neighborhoods.
+type Neighborhood int
+
+const (
+	// Moore neighborhoods include tiles within Radius
+	// horizontally and vertically, 8 tiles for Radius 1.
+	Moore Neighborhood = iota
+
+	// VonNeumann neighborhoods include tiles within
+	// Manhattan distance Radius, 4 tiles for Radius 1.
+	VonNeumann
+)
+
+// Boundary specifies the tiles beyond the edges of areas.
+type Boundary int
+
+const (
+	// Dead boundaries are filled with the default tile.
+	Dead Boundary = iota
+
+	// Wrap boundaries continue at the opposite edge.
+	Wrap
+
+	// Reflect boundaries mirror the area at its edges,
+	// so that the tile left of x=0 is that at x=0.
+	Reflect
+)
+
+// Transition changes a tile From to To
+// if its neighborhood satisfies all of If.
+type Transition struct {
+	From, To byte
+	If       []Count
+}
+
+// Count is satisfied by neighborhoods having
+// Min to Max tiles of Tile. Negative Max means no upper limit.
+type Count struct {
+	Tile     byte
+	Min, Max int
+}
+
+// Lumber are the rules of the lumber collection area.
+var Lumber = &Rules{
+	Alphabet: ".|#",
+	Transitions: []Transition{
+		// open acres become trees with three or more trees around
+		{From: '.', To: '|', If: []Count{{'|', 3, -1}}},
+
+		// trees become lumberyards with three or more lumberyards around
+		{From: '|', To: '#', If: []Count{{'#', 3, -1}}},
+
+		// lumberyards remain next to a tree and a lumberyard,
+		// otherwise they become open
+		{From: '#', To: '#', If: []Count{{'|', 1, -1}, {'#', 1, -1}}},
+		{From: '#', To: '.'},
+	},
+}
+
+// LifeRules returns the rules of a Life-like automaton
+// with the rule string rule in B/S notation, such as "B3/S23"
+// for Conway's Game of Life. Tiles are '.' for dead
+// and '#' for live cells.
+func LifeRules(rule string) (*Rules, error) {
+	parts := strings.Split(strings.ToUpper(rule), "/")
+	if len(parts) != 2 {
+		return nil, errors.Errorf("invalid life rule %q", rule)
+	}
+
+	born, survive := parts[0], parts[1]
+	if !strings.HasPrefix(born, "B") {
+		born, survive = survive, born
+	}
+	if !strings.HasPrefix(born, "B") || !strings.HasPrefix(survive, "S") {
+		return nil, errors.Errorf("invalid life rule %q", rule)
+	}
+	born, survive = born[1:], survive[1:]
+
+	r := &Rules{Alphabet: ".#"}
+	add := func(digits string, from byte) error {
+		for _, d := range digits {
+			if d < '0' || d > '8' {
+				return errors.Errorf("invalid life rule %q", rule)
+			}
+			n := int(d - '0')
+			r.Transitions = append(r.Transitions, Transition{
+				From: from,
+				To:   '#',
+				If:   []Count{{'#', n, n}},
+			})
+		}
+		return nil
+	}
+	if err := add(born, '.'); err != nil {
+		return nil, err
+	}
+	if err := add(survive, '#'); err != nil {
+		return nil, err
+	}
+	r.Transitions = append(r.Transitions, Transition{From: '#', To: '.'})
+	return r, nil
+}
+
+// automaton is the compiled form of Rules.
+type automaton struct {
+	alphabet string
+	index    [256]int // index of tiles in alphabet, or -1
+
+	radius   int
+	boundary Boundary
+	nbors    [][2]int // dx, dy offsets of the neighborhood
+
+	trans [][]transition // transitions by tile
+}
+
+type transition struct {
+	to    tile
+	conds []count
+}
+
+type count struct {
+	t        tile
+	min, max int
+}
+
+func (c *transition) match(counts []int) bool {
+	for _, x := range c.conds {
+		n := counts[x.t]
+		if n < x.min || (x.max >= 0 && n > x.max) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Rules) compile() (*automaton, error) {
+	if len(r.Alphabet) == 0 {
+		return nil, errors.New("empty alphabet")
+	}
+
+	ca := &automaton{
+		alphabet: r.Alphabet,
+		radius:   r.Radius,
+		boundary: r.Boundary,
+		trans:    make([][]transition, len(r.Alphabet)),
+	}
+	for i := range ca.index {
+		ca.index[i] = -1
+	}
+	for i := 0; i < len(r.Alphabet); i++ {
+		c := r.Alphabet[i]
+		if ca.index[c] >= 0 {
+			return nil, errors.Errorf("duplicate tile %q", c)
+		}
+		ca.index[c] = i
+	}
+
+	tileOf := func(c byte) (tile, error) {
+		if i := ca.index[c]; i >= 0 {
+			return tile(i), nil
+		}
+		return 0, errors.Errorf("tile %q not in alphabet", c)
+	}
+
+	for i, t := range r.Transitions {
+		from, err := tileOf(t.From)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transition %d", i)
+		}
+		to, err := tileOf(t.To)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transition %d", i)
+		}
+		x := transition{to: to}
+		for _, c := range t.If {
+			ct, err := tileOf(c.Tile)
+			if err != nil {
+				return nil, errors.Wrapf(err, "transition %d", i)
+			}
+			x.conds = append(x.conds, count{ct, c.Min, c.Max})
+		}
+		ca.trans[from] = append(ca.trans[from], x)
+	}
+
+	switch {
+	case ca.radius == 0:
+		ca.radius = 1
+	case ca.radius < 0:
+		return nil, errors.Errorf("invalid radius %d", r.Radius)
+	}
+	rad := ca.radius
+	for dy := -rad; dy <= rad; dy++ {
+		for dx := -rad; dx <= rad; dx++ {
+			if dx == 0 && dy == 0 {
+				continue
+			}
+			switch r.Neighborhood {
+			case Moore:
+			case VonNeumann:
+				if abs(dx)+abs(dy) > rad {
+					continue
+				}
+			default:
+				return nil, errors.Errorf("invalid neighborhood %d", r.Neighborhood)
+			}
+			ca.nbors = append(ca.nbors, [2]int{dx, dy})
+		}
+	}
+
+	switch r.Boundary {
+	case Dead, Wrap, Reflect:
+	default:
+		return nil, errors.Errorf("invalid boundary %d", r.Boundary)
+	}
+
+	return ca, nil
+}
+
+// fold returns the coordinate within [0, n) of i
+// beyond the edges of an area according to b.
+func (b Boundary) fold(i, n int) int {
+	switch b {
+	case Wrap:
+		i %= n
+		if i < 0 {
+			i += n
+		}
+	case Reflect:
+		i %= 2 * n
+		if i < 0 {
+			i += 2 * n
+		}
+		if i >= n {
+			i = 2*n - 1 - i
+		}
+	}
+	return i
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
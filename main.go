@@ -2,40 +2,85 @@ package main
 
 import (
 	"flag"
-	"io"
-	"io/ioutil"
+	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strconv"
 )
 
 var verbose bool
 
-var verbosew io.Writer
+type puzzle struct {
+	n int
+
+	// f runs the puzzle, reporting answers to rep
+	f func(rep *Report) error
+}
 
 func main() {
 	flag.BoolVar(&verbose, "v", false, "verbose mode")
+	inputDir := flag.String("input", "", "read puzzle inputs from `dir`")
+	cacheDir := flag.String("cache", "", "puzzle input cache `dir` (default: user cache dir)")
+	fromStdin := flag.Bool("stdin", false, "read input of the single selected puzzle from stdin")
+	checkFile := flag.String("check", "", "check answers against golden answers `file`")
+	benchMode := flag.Bool("bench", false, "report time and memory use of puzzle parts")
+	cpuProfile := flag.String("cpuprofile", "", "write CPU profile of the selected puzzle to `file`")
+	memProfile := flag.String("memprofile", "", "write heap profile of the selected puzzle to `file`")
+	jobs := flag.Int("j", 1, "run up to `n` puzzles concurrently")
+	format := flag.String("format", "text", "output `format`: text or json")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: aoc18 [flags] [puzzle...]")
+		fmt.Fprintln(flag.CommandLine.Output(), "       aoc18 debug [flags] file")
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
-	if verbose {
-		verbosew = os.Stdout
-	} else {
-		verbosew = ioutil.Discard
+	if flag.Arg(0) == "debug" {
+		os.Exit(debugMain(flag.Args()[1:]))
 	}
 
-	type puzzle struct {
-		n int
-		f func()
+	var write func(r *puzzleRun) error
+	switch *format {
+	case "text":
+		write = func(r *puzzleRun) error {
+			if r.err != nil {
+				log.Printf("puzzle %d failed: %v", r.n, r.err)
+			}
+			return r.rep.WriteText(os.Stdout)
+		}
+	case "json":
+		write = func(r *puzzleRun) error {
+			return r.rep.WriteJSON(os.Stdout, r.err)
+		}
+	default:
+		log.Fatalf("unknown format: %q", *format)
 	}
 
 	var puzzles []puzzle
-	pm := make(map[int]func())
+	pm := make(map[int]puzzle)
 
-	add := func(n int, f func()) {
-		puzzles = append(puzzles, puzzle{n: n, f: f})
-		pm[n] = f
+	add := func(n int, f func(rep *Report) error) {
+		p := puzzle{n: n, f: f}
+		puzzles = append(puzzles, p)
+		pm[n] = p
 	}
 
+	add(1, chronalcalibration)
+	add(2, inventorymanagement)
+	add(3, fabricclaims)
+	add(4, guardrecords)
+	add(5, alchemicalpolymer)
+	add(6, chronalcoords)
+	add(7, assemblysteps)
+	add(8, memorytree)
+	add(9, marblemania)
+	add(10, starsalign)
+	add(11, chargefuelcells)
+	add(12, cavepots)
+	add(13, minecartmadness)
+	add(14, chocolatecharts)
+	add(15, goblinbattle)
 	add(16, wristdevhack)
 	add(17, reservoirresearch)
 	add(18, collectlumber)
@@ -47,18 +92,112 @@ func main() {
 	add(24, immunesysbattle)
 	add(25, constellations)
 
-	if flag.NArg() == 0 {
-		for _, p := range puzzles {
-			p.f()
+	var stdinPuzzle int
+	if *fromStdin {
+		if flag.NArg() != 1 {
+			log.Fatal("-stdin needs exactly one puzzle")
+		}
+		n, err := strconv.Atoi(flag.Arg(0))
+		if _, ok := pm[n]; err != nil || !ok {
+			log.Fatalf("-stdin: invalid puzzle %q", flag.Arg(0))
 		}
-	} else {
+		stdinPuzzle = n
+	}
+	puzzleInput = inputResolver(*inputDir, *cacheDir, stdinPuzzle)
+
+	sel := puzzles
+	if flag.NArg() != 0 {
+		sel = nil
 		for _, arg := range flag.Args() {
 			n, _ := strconv.Atoi(arg)
-			if f, ok := pm[n]; ok {
-				f()
+			if p, ok := pm[n]; ok {
+				sel = append(sel, p)
 			} else {
 				log.Printf("unknown puzzle: %v", arg)
 			}
 		}
 	}
+
+	if *checkFile != "" {
+		os.Exit(checkPuzzles(sel, *jobs, *checkFile))
+	}
+
+	if *benchMode {
+		if err := benchPuzzles(os.Stdout, sel); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *cpuProfile != "" || *memProfile != "" {
+		if len(sel) != 1 {
+			log.Fatal("profiling needs exactly one puzzle")
+		}
+		rep := newReport(sel[0].n)
+		err := profilePuzzle(rep, sel[0], *cpuProfile, *memProfile)
+		rep.WriteText(os.Stdout)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	nfail := 0
+	runPuzzles(sel, *jobs, func(r *puzzleRun) {
+		if r.err != nil {
+			nfail++
+		}
+		if err := write(r); err != nil {
+			log.Fatal(err)
+		}
+	})
+
+	if nfail != 0 {
+		os.Exit(1)
+	}
+}
+
+// inputResolver sets up puzzle input sources in order of precedence:
+// stdin (if stdinPuzzle is nonzero), the -input directory,
+// the directory in $AOC18_INPUT, the cache and the source checkout.
+func inputResolver(inputDir, cacheDir string, stdinPuzzle int) *InputResolver {
+	r := new(InputResolver)
+
+	if cacheDir == "" {
+		dir, err := DefaultCacheDir()
+		if err != nil && verbose {
+			log.Println("no input cache:", err)
+		}
+		cacheDir = dir
+	}
+
+	var cache *CacheSource
+	if cacheDir != "" {
+		cache = &CacheSource{DirSource(cacheDir)}
+	}
+
+	if stdinPuzzle != 0 {
+		r.Sources = append(r.Sources, &ReaderSource{
+			N:     stdinPuzzle,
+			Name:  "stdin",
+			R:     os.Stdin,
+			Cache: cache,
+		})
+	}
+
+	if inputDir != "" {
+		r.Sources = append(r.Sources, DirSource(inputDir))
+	}
+
+	if dir := os.Getenv("AOC18_INPUT"); dir != "" {
+		r.Sources = append(r.Sources, DirSource(dir))
+	}
+
+	if cache != nil {
+		r.Sources = append(r.Sources, *cache)
+	}
+
+	r.Sources = append(r.Sources, DirSource(filepath.Join(pkgdir(), "input")))
+
+	return r
 }
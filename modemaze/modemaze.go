@@ -2,6 +2,7 @@ package modemaze
 
 import (
 	"bytes"
+	"context"
 	"io"
 
 	"github.com/tajtiattila/aoc18/astar"
@@ -180,7 +181,9 @@ type pathState struct {
 	tool uint8
 }
 
-func (m *Map) PathDuration() (minutes int) {
+// PathDuration returns the minimum number of minutes
+// to reach the target with the torch equipped.
+func (m *Map) PathDuration(ctx context.Context) (minutes int, err error) {
 
 	const (
 		costStep       = 1
@@ -191,7 +194,7 @@ func (m *Map) PathDuration() (minutes int) {
 		tool: toolTorch,
 	}
 
-	add := func(dst *[]astar.State, cost int, p pathState) {
+	add := func(dst *[]astar.Step[pathState], cost int, p pathState) {
 		dx := p.x - m.Target.X
 		if dx < 0 {
 			dx = -dx
@@ -208,15 +211,14 @@ func (m *Map) PathDuration() (minutes int) {
 			estimate += costToolSwitch
 		}
 
-		*dst = append(*dst, astar.State{
+		*dst = append(*dst, astar.Step[pathState]{
 			Point:        p,
 			Cost:         cost,
 			EstimateLeft: estimate,
 		})
 	}
 
-	_, tc := astar.FindPath(start, func(p0 astar.Point, dst []astar.State) (adjacents []astar.State) {
-		p := p0.(pathState)
+	adjacent := func(p pathState, dst []astar.Step[pathState]) []astar.Step[pathState] {
 		vstride := m.dx
 		ofs := p.x + p.y*vstride
 
@@ -266,9 +268,23 @@ func (m *Map) PathDuration() (minutes int) {
 		}
 
 		return dst
-	})
+	}
+
+	const ntools = 3
+	search := astar.Search[pathState]{
+		Adjacent: adjacent,
+		Index: func(p pathState) int {
+			return (p.x+p.y*m.dx)*ntools + int(p.tool)
+		},
+
+		// Any two region types have a tool in common,
+		// therefore a path of steps straight towards the target
+		// with at most one tool switch before each is always possible.
+		MaxCost: (m.Target.X+m.Target.Y+1)*(costStep+costToolSwitch) + costToolSwitch,
+	}
 
-	return tc
+	res, err := search.Run(ctx, start)
+	return res.Cost, err
 }
 
 func switchTool(tile Tile, tool uint8) uint8 {
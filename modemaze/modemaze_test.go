@@ -2,6 +2,7 @@ package modemaze
 
 import (
 	"bytes"
+	"context"
 	"testing"
 )
 
@@ -19,7 +20,10 @@ func TestSample(t *testing.T) {
 		t.Fatalf("got risk level %v; want %v", got, want)
 	}
 
-	got = m.PathDuration()
+	got, err := m.PathDuration(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
 	want = 45
 
 	if got != want {
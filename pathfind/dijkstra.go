@@ -0,0 +1,145 @@
+package pathfind
+
+// Edge leads to an adjacent place.
+type Edge[P comparable] struct {
+	To P
+
+	// Weight is the cost of taking the edge.
+	// It must not be negative.
+	Weight int
+}
+
+// Graph is a weighted graph of places of type P.
+type Graph[P comparable] struct {
+	// Adjacent finds edges leaving p,
+	// appending them to dst.
+	Adjacent func(p P, dst []Edge[P]) []Edge[P]
+}
+
+// Paths holds the shortest paths from a place.
+type Paths[P comparable] struct {
+	From P
+
+	// Dist holds the distance of places reached.
+	Dist map[P]int
+
+	// Pred holds the predecessor of places on
+	// their shortest paths, except for From.
+	Pred map[P]P
+}
+
+// Distance returns the distance of to,
+// or NotReached if it was not reached.
+func (r *Paths[P]) Distance(to P) int {
+	if d, ok := r.Dist[to]; ok {
+		return d
+	}
+	return NotReached
+}
+
+// PathTo returns the shortest path from r.From to to,
+// including both. It returns nil if to was not reached.
+func (r *Paths[P]) PathTo(to P) []P {
+	if _, ok := r.Dist[to]; !ok {
+		return nil
+	}
+
+	path := []P{to}
+	for p := to; p != r.From; {
+		p = r.Pred[p]
+		path = append(path, p)
+	}
+
+	// reverse path
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// Dijkstra finds the shortest paths from place from in g.
+func (g Graph[P]) Dijkstra(from P) *Paths[P] {
+	r := &Paths[P]{
+		From: from,
+		Dist: map[P]int{from: 0},
+		Pred: make(map[P]P),
+	}
+
+	done := make(map[P]bool)
+	active := &distHeap[P]{}
+	active.push(distItem[P]{p: from})
+
+	var edges []Edge[P]
+	for len(active.v) > 0 {
+		a := active.pop()
+		if done[a.p] {
+			continue
+		}
+		done[a.p] = true
+
+		edges = g.Adjacent(a.p, edges[:0])
+		for _, e := range edges {
+			if e.Weight < 0 {
+				panic("weight must not be negative")
+			}
+
+			d := a.dist + e.Weight
+			if od, ok := r.Dist[e.To]; ok && od <= d {
+				continue
+			}
+
+			r.Dist[e.To] = d
+			r.Pred[e.To] = a.p
+			active.push(distItem[P]{p: e.To, dist: d})
+		}
+	}
+
+	return r
+}
+
+type distItem[P comparable] struct {
+	p    P
+	dist int
+}
+
+// distHeap is a priority queue of places by distance.
+type distHeap[P comparable] struct {
+	v []distItem[P]
+}
+
+func (h *distHeap[P]) push(x distItem[P]) {
+	// sift up
+	h.v = append(h.v, x)
+	for j := len(h.v) - 1; j > 0; {
+		i := (j - 1) / 2 // parent
+		if h.v[i].dist <= h.v[j].dist {
+			break
+		}
+		h.v[i], h.v[j] = h.v[j], h.v[i]
+		j = i
+	}
+}
+
+func (h *distHeap[P]) pop() distItem[P] {
+	n := len(h.v) - 1
+	top := h.v[0]
+	h.v[0] = h.v[n]
+	h.v = h.v[:n]
+
+	// sift down
+	for i := 0; ; {
+		j := 2*i + 1 // left child
+		if j >= n {
+			break
+		}
+		if r := j + 1; r < n && h.v[r].dist < h.v[j].dist {
+			j = r
+		}
+		if h.v[i].dist <= h.v[j].dist {
+			break
+		}
+		h.v[i], h.v[j] = h.v[j], h.v[i]
+		i = j
+	}
+	return top
+}
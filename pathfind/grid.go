@@ -0,0 +1,78 @@
+package pathfind
+
+// Dir is a direction on a Grid.
+type Dir int
+
+const (
+	North Dir = iota
+	South
+	West
+	East
+)
+
+// Grid is a rectangular grid of Dx × Dy cells.
+//
+// Cells are identified with their index x + y*Dx.
+type Grid struct {
+	Dx, Dy int
+
+	// CanStep reports if one can step from cell i in direction d.
+	//
+	// It is called only for steps staying within the grid.
+	CanStep func(i int, d Dir) bool
+}
+
+// Index returns the index of the cell at x, y.
+func (g *Grid) Index(x, y int) int { return x + y*g.Dx }
+
+// Flood finds the distance of cells from cell start
+// using breadth-first search.
+//
+// Distances are stored in dist, indexed by cell, which is
+// reallocated only if it is shorter than the number of cells.
+// Cells not reached are set to NotReached.
+func (g *Grid) Flood(start int, dist []int) (res []int, maxDist int) {
+	n := g.Dx * g.Dy
+	if cap(dist) < n {
+		dist = make([]int, n)
+	}
+	dist = dist[:n]
+	for i := range dist {
+		dist[i] = NotReached
+	}
+
+	dist[start] = 0
+	active := []int{start}
+	var nextactive []int
+
+	for d := 1; len(active) != 0; d++ {
+		for _, i := range active {
+			x, y := i%g.Dx, i/g.Dx
+
+			step := func(dir Dir, j int) {
+				if dist[j] == NotReached && g.CanStep(i, dir) {
+					dist[j] = d
+					nextactive = append(nextactive, j)
+					maxDist = d
+				}
+			}
+
+			if y > 0 {
+				step(North, i-g.Dx)
+			}
+			if y+1 < g.Dy {
+				step(South, i+g.Dx)
+			}
+			if x > 0 {
+				step(West, i-1)
+			}
+			if x+1 < g.Dx {
+				step(East, i+1)
+			}
+		}
+
+		active, nextactive = nextactive, active[:0]
+	}
+
+	return dist, maxDist
+}
@@ -1,15 +1,11 @@
 package pathfind
 
-// Place represents a place in the problem space,
-// such as a grid position or graph node pointer.
-//
-// A Place must be equality comparable.
-type Place interface{}
-
-type Space struct {
+// Space is an unweighted graph of places of type P,
+// such as grid positions or graph node pointers.
+type Space[P comparable] struct {
 	// Adjacent finds places adjacent to p,
 	// appending them to dst.
-	Adjacent func(p Place, dst []Place) (adjacents []Place)
+	Adjacent func(p P, dst []P) (adjacents []P)
 
 	// Step is called from Flood when p is reached
 	// the first time.
@@ -20,13 +16,13 @@ type Space struct {
 	// processing stops after processing the current distance.
 	//
 	// Step may be nil.
-	Step func(p Place) (cont bool)
+	Step func(p P) (cont bool)
 }
 
 // Place -> distance
-type FloodResult map[Place]int
+type FloodResult[P comparable] map[P]int
 
-func (m FloodResult) Distance(to Place) int {
+func (m FloodResult[P]) Distance(to P) int {
 	if d, ok := m[to]; ok {
 		return d
 	}
@@ -35,22 +31,19 @@ func (m FloodResult) Distance(to Place) int {
 
 const NotReached = int(^uint(0) >> 1)
 
-func Flood(from Place, space Space) (res FloodResult, maxDist int) {
+func Flood[P comparable](from P, space Space[P]) (res FloodResult[P], maxDist int) {
 
 	if space.Step == nil {
-		space.Step = func(p Place) bool { return true }
+		space.Step = func(p P) bool { return true }
 	}
 
-	m := make(FloodResult)
+	m := make(FloodResult[P])
 
 	dist := 0
-	active := []Place{from}
+	active := []P{from}
 	m[from] = dist
 
-	var nextactive []Place
-
-	var adjbuf [16]Place
-	adj := adjbuf[:]
+	var nextactive, adj []P
 
 	done := false
 	for !done && len(active) != 0 {
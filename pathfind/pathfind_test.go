@@ -0,0 +1,90 @@
+package pathfind
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFlood(t *testing.T) {
+	// chain 0 - 1 - 2 - 3
+	space := Space[int]{
+		Adjacent: func(p int, dst []int) []int {
+			if p > 0 {
+				dst = append(dst, p-1)
+			}
+			if p < 3 {
+				dst = append(dst, p+1)
+			}
+			return dst
+		},
+	}
+
+	res, maxDist := Flood(1, space)
+	if maxDist != 2 || res.Distance(3) != 2 || res.Distance(7) != NotReached {
+		t.Errorf("got %v, max distance %d", res, maxDist)
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	edges := map[string][]Edge[string]{
+		"a": {{"b", 7}, {"c", 9}, {"f", 14}},
+		"b": {{"a", 7}, {"c", 10}, {"d", 15}},
+		"c": {{"a", 9}, {"b", 10}, {"d", 11}, {"f", 2}},
+		"d": {{"b", 15}, {"c", 11}, {"e", 6}},
+		"e": {{"d", 6}, {"f", 9}},
+		"f": {{"a", 14}, {"c", 2}, {"e", 9}},
+	}
+	g := Graph[string]{
+		Adjacent: func(p string, dst []Edge[string]) []Edge[string] {
+			return append(dst, edges[p]...)
+		},
+	}
+
+	r := g.Dijkstra("a")
+	if d := r.Distance("e"); d != 20 {
+		t.Errorf("got distance %d; want 20", d)
+	}
+	if p := fmt.Sprint(r.PathTo("e")); p != "[a c f e]" {
+		t.Errorf("got path %s; want [a c f e]", p)
+	}
+	if r.Distance("x") != NotReached || r.PathTo("x") != nil {
+		t.Errorf("unknown place reached")
+	}
+}
+
+func TestGridFlood(t *testing.T) {
+	grid := []string{
+		"..#",
+		".##",
+		"...",
+	}
+
+	g := Grid{
+		Dx: 3,
+		Dy: 3,
+		CanStep: func(i int, d Dir) bool {
+			j := i
+			switch d {
+			case North:
+				j -= 3
+			case South:
+				j += 3
+			case West:
+				j--
+			case East:
+				j++
+			}
+			return grid[j/3][j%3] == '.'
+		},
+	}
+
+	dist, maxDist := g.Flood(g.Index(1, 0), nil)
+	if maxDist != 5 || dist[g.Index(2, 2)] != 5 || dist[g.Index(2, 0)] != NotReached {
+		t.Errorf("got distances %v, max %d", dist, maxDist)
+	}
+
+	again, _ := g.Flood(g.Index(2, 2), dist)
+	if &again[0] != &dist[0] || again[g.Index(1, 0)] != 5 {
+		t.Errorf("got distances %v", again)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+// Result is the answer of a puzzle part.
+type Result struct {
+	Day      int           `json:"day"`
+	Part     int           `json:"part"`
+	Answer   string        `json:"answer"`
+	Duration time.Duration `json:"duration_ns"`
+
+	// Diagnostics written while solving the part in verbose mode.
+	Diagnostics string `json:"diagnostics,omitempty"`
+}
+
+// Report collects the results of a puzzle.
+//
+// Solvers use Answer to report their answers,
+// and Diag to write diagnostics.
+type Report struct {
+	Day     int
+	Results []Result
+
+	// Diag receives diagnostics of the part being solved.
+	// It discards output unless in verbose mode.
+	Diag io.Writer
+
+	diag  bytes.Buffer
+	start time.Time
+
+	// hook, if not nil, is called with each result
+	// before it is added to Results.
+	hook func(res *Result)
+}
+
+func newReport(day int) *Report {
+	r := &Report{Day: day}
+	if verbose {
+		r.Diag = &r.diag
+	} else {
+		r.Diag = ioutil.Discard
+	}
+	r.start = time.Now()
+	return r
+}
+
+// Answer reports the answer v for part.
+//
+// The duration of part is measured from the
+// previous answer or the start of the puzzle.
+func (r *Report) Answer(part int, v interface{}) {
+	res := Result{
+		Day:         r.Day,
+		Part:        part,
+		Answer:      strings.TrimRight(fmt.Sprint(v), "\n"),
+		Duration:    time.Since(r.start),
+		Diagnostics: r.diag.String(),
+	}
+	r.diag.Reset()
+
+	if r.hook != nil {
+		r.hook(&res)
+	}
+
+	r.Results = append(r.Results, res)
+	r.start = time.Now()
+}
+
+// Answers returns the answers in r.
+func (r *Report) Answers() Answers {
+	m := make(Answers)
+	for _, res := range r.Results {
+		m[AnswerKey{Day: res.Day, Part: res.Part}] = res.Answer
+	}
+	return m
+}
+
+// trailingDiag returns diagnostics written after the last answer.
+func (r *Report) trailingDiag() string {
+	return r.diag.String()
+}
+
+// WriteText writes results in r in the text format
+// parsed by ParseAnswers, preceded by diagnostics.
+func (r *Report) WriteText(w io.Writer) error {
+	var buf bytes.Buffer
+	for _, res := range r.Results {
+		buf.WriteString(res.Diagnostics)
+		if strings.Contains(res.Answer, "\n") {
+			fmt.Fprintf(&buf, "%d/%d:\n%s\n", res.Day, res.Part, res.Answer)
+		} else {
+			fmt.Fprintf(&buf, "%d/%d: %s\n", res.Day, res.Part, res.Answer)
+		}
+	}
+	buf.WriteString(r.trailingDiag())
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// jsonRecord is a line of JSON output.
+type jsonRecord struct {
+	Result
+
+	Error string `json:"error,omitempty"`
+}
+
+// WriteJSON writes results in r to w as JSON objects, one per line.
+//
+// If err is not nil, it is reported in a record with zero part.
+func (r *Report) WriteJSON(w io.Writer, err error) error {
+	enc := json.NewEncoder(w)
+	for _, res := range r.Results {
+		if err := enc.Encode(jsonRecord{Result: res}); err != nil {
+			return err
+		}
+	}
+
+	if err != nil || r.trailingDiag() != "" {
+		rec := jsonRecord{
+			Result: Result{
+				Day:         r.Day,
+				Diagnostics: r.trailingDiag(),
+			},
+		}
+		if err != nil {
+			rec.Error = err.Error()
+		}
+		return enc.Encode(rec)
+	}
+
+	return nil
+}
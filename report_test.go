@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestReportText(t *testing.T) {
+	rep := newReport(10)
+	rep.Answer(1, "#..\n.#.\n")
+	rep.Answer(2, 3)
+
+	var buf bytes.Buffer
+	if err := rep.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "10/1:\n#..\n.#.\n10/2: 3\n"
+	if buf.String() != want {
+		t.Fatalf("got %q; want %q", buf.String(), want)
+	}
+
+	got, err := ParseAnswers(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(rep.Answers()) {
+		t.Errorf("parsed %v; want %v", got, rep.Answers())
+	}
+}
+
+func TestReportJSON(t *testing.T) {
+	rep := newReport(4)
+	rep.Answer(1, 42)
+
+	var buf bytes.Buffer
+	if err := rep.WriteJSON(&buf, errors.New("no input")); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d records; want 2:\n%s", len(lines), buf.String())
+	}
+
+	var recs [2]jsonRecord
+	for i, line := range lines {
+		if err := json.Unmarshal([]byte(line), &recs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if r := recs[0]; r.Day != 4 || r.Part != 1 || r.Answer != "42" || r.Error != "" {
+		t.Errorf("got answer record %+v", r)
+	}
+	if r := recs[1]; r.Day != 4 || r.Part != 0 || r.Error != "no input" {
+		t.Errorf("got error record %+v", r)
+	}
+}
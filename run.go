@@ -0,0 +1,76 @@
+package main
+
+import (
+	"runtime/debug"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// runPuzzle runs p reporting results to rep.
+//
+// A panic in p is recovered and reported as an error.
+func runPuzzle(p puzzle, rep *Report) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+	return p.f(rep)
+}
+
+// puzzleRun is the outcome of running a puzzle.
+type puzzleRun struct {
+	puzzle
+
+	rep *Report
+	err error
+
+	done chan struct{}
+}
+
+// runPuzzles runs puzzles using up to jobs goroutines.
+//
+// Results of each puzzle are collected, and report is called
+// with the runs in the order of puzzles.
+func runPuzzles(puzzles []puzzle, jobs int, report func(r *puzzleRun)) {
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	runs := make([]*puzzleRun, len(puzzles))
+	for i, p := range puzzles {
+		runs[i] = &puzzleRun{
+			puzzle: p,
+			done:   make(chan struct{}),
+		}
+	}
+
+	work := make(chan *puzzleRun)
+	var wg sync.WaitGroup
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range work {
+				r.rep = newReport(r.n)
+				r.err = runPuzzle(r.puzzle, r.rep)
+				close(r.done)
+			}
+		}()
+	}
+
+	go func() {
+		for _, r := range runs {
+			work <- r
+		}
+		close(work)
+	}()
+
+	for _, r := range runs {
+		<-r.done
+		report(r)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func TestRunPuzzles(t *testing.T) {
+	answer := func(n int, d time.Duration) func(rep *Report) error {
+		return func(rep *Report) error {
+			time.Sleep(d)
+			rep.Answer(1, n*n)
+			return nil
+		}
+	}
+
+	puzzles := []puzzle{
+		{n: 1, f: answer(1, 20*time.Millisecond)},
+		{n: 2, f: func(rep *Report) error {
+			rep.Answer(1, 4)
+			panic("derailed")
+		}},
+		{n: 3, f: answer(3, 0)},
+		{n: 4, f: func(rep *Report) error {
+			return errors.New("no input")
+		}},
+		{n: 5, f: answer(5, 10*time.Millisecond)},
+	}
+
+	for _, jobs := range []int{1, 2, 8} {
+		var order []int
+		var out strings.Builder
+		failed := make(map[int]string)
+		runPuzzles(puzzles, jobs, func(r *puzzleRun) {
+			order = append(order, r.n)
+			r.rep.WriteText(&out)
+			if r.err != nil {
+				failed[r.n] = r.err.Error()
+			}
+		})
+
+		if got := fmt.Sprint(order); got != "[1 2 3 4 5]" {
+			t.Errorf("jobs=%d: got order %s", jobs, got)
+		}
+
+		want := "1/1: 1\n2/1: 4\n3/1: 9\n5/1: 25\n"
+		if out.String() != want {
+			t.Errorf("jobs=%d: got output %q; want %q", jobs, out.String(), want)
+		}
+
+		if len(failed) != 2 ||
+			!strings.HasPrefix(failed[2], "panic: derailed") ||
+			failed[4] != "no input" {
+			t.Errorf("jobs=%d: got failures %q", jobs, failed)
+		}
+	}
+}
@@ -2,24 +2,32 @@ package main
 
 import (
 	"bufio"
-	"fmt"
 	"io"
-	"log"
-	"os"
 	"path/filepath"
 	"runtime"
+
+	"github.com/pkg/errors"
 )
 
-func OpenPuzzleInput(n int) io.ReadCloser {
-	f, err := os.Open(filepath.Join(pkgdir(), "input", fmt.Sprintf("%02d.txt", n)))
-	if err != nil {
-		log.Fatalf("open puzzle input %02d: %v", n, err)
-	}
-	return f
+// puzzleInput is the resolver used by OpenPuzzleInput.
+//
+// It is set up by main from the command line; by default
+// only the input directory of the source checkout is used.
+var puzzleInput = &InputResolver{
+	Sources: []InputSource{
+		DirSource(filepath.Join(pkgdir(), "input")),
+	},
 }
 
-func PuzzleInputLines(n int) []string {
-	f := OpenPuzzleInput(n)
+func OpenPuzzleInput(n int) (io.ReadCloser, error) {
+	return puzzleInput.Open(n)
+}
+
+func PuzzleInputLines(n int) ([]string, error) {
+	f, err := OpenPuzzleInput(n)
+	if err != nil {
+		return nil, err
+	}
 	defer f.Close()
 
 	var lines []string
@@ -28,9 +36,9 @@ func PuzzleInputLines(n int) []string {
 		lines = append(lines, scanner.Text())
 	}
 	if err := scanner.Err(); err != nil {
-		log.Fatalf("reading puzzle input %02d: %v", n, err)
+		return nil, errors.Wrapf(err, "reading puzzle input %02d", n)
 	}
-	return lines
+	return lines, nil
 }
 
 func pkgdir() string {
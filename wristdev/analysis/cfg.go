@@ -0,0 +1,228 @@
+// Package analysis reconstructs the control flow of wristdev programs.
+package analysis
+
+import (
+	"sort"
+
+	"github.com/tajtiattila/aoc18/wristdev"
+)
+
+// JumpKind is the kind of control transfer of an instruction.
+type JumpKind int
+
+const (
+	// Next means execution continues with the next instruction.
+	Next JumpKind = iota
+
+	// Goto is a jump to a constant target.
+	Goto
+
+	// Branch is a conditional jump. It goes to the
+	// target if the condition register is 1,
+	// otherwise to the next instruction.
+	Branch
+
+	// Indirect is a jump to a target computed at run time.
+	Indirect
+)
+
+// Jump is the resolved control transfer of an instruction.
+type Jump struct {
+	Kind JumpKind
+
+	// Target is the address of the next instruction
+	// for Goto and the taken Branch.
+	// It may be outside of the program, meaning a halt.
+	Target int
+
+	// Cond is the condition register of a Branch.
+	Cond int
+}
+
+// Exit is the successor of blocks that leave the program.
+const Exit = -1
+
+// Block is a basic block of instructions.
+type Block struct {
+	Index int // index in CFG.Blocks
+
+	Start, End int // instruction addresses [Start, End)
+
+	// Jump is the jump ending the block.
+	Jump Jump
+
+	// Succ holds the indices of successor blocks, or Exit.
+	// For a Branch the first one is the taken target.
+	// Blocks ending in an Indirect jump have no successors.
+	Succ []int
+
+	// Pred holds the indices of predecessor blocks.
+	Pred []int
+}
+
+// CFG is the control flow graph of a program.
+type CFG struct {
+	Arch *wristdev.Architecture
+	Prog []wristdev.Instruction
+
+	Jumps  []Jump // per instruction
+	Blocks []*Block
+
+	blockOf []int // block index of instructions
+}
+
+// Build builds the control flow graph of prog running on arch.
+func Build(arch *wristdev.Architecture, prog []wristdev.Instruction) *CFG {
+	g := &CFG{
+		Arch:  arch,
+		Prog:  prog,
+		Jumps: make([]Jump, len(prog)),
+	}
+
+	for i := range prog {
+		g.Jumps[i] = g.resolveJump(i)
+	}
+
+	leader := make([]bool, len(prog)+1)
+	leader[0] = true
+	leader[len(prog)] = true
+	for i, j := range g.Jumps {
+		switch j.Kind {
+		case Goto, Branch:
+			if 0 <= j.Target && j.Target < len(prog) {
+				leader[j.Target] = true
+			}
+		}
+		if j.Kind != Next {
+			leader[i+1] = true
+		}
+	}
+
+	// a branch condition may not be a boolean
+	// when the branch is reached by a jump
+	for i, j := range g.Jumps {
+		if j.Kind == Branch && leader[i] {
+			g.Jumps[i] = Jump{Kind: Indirect}
+		}
+	}
+
+	g.blockOf = make([]int, len(prog))
+	for i := 0; i < len(prog); {
+		b := &Block{
+			Index: len(g.Blocks),
+			Start: i,
+		}
+		for {
+			g.blockOf[i] = b.Index
+			i++
+			if leader[i] {
+				break
+			}
+		}
+		b.End = i
+		b.Jump = g.Jumps[i-1]
+		g.Blocks = append(g.Blocks, b)
+	}
+
+	for _, b := range g.Blocks {
+		switch b.Jump.Kind {
+		case Next:
+			b.Succ = []int{g.BlockAt(b.End)}
+		case Goto:
+			b.Succ = []int{g.BlockAt(b.Jump.Target)}
+		case Branch:
+			b.Succ = []int{g.BlockAt(b.Jump.Target), g.BlockAt(b.End)}
+		}
+		for _, s := range b.Succ {
+			if s != Exit {
+				g.Blocks[s].Pred = append(g.Blocks[s].Pred, b.Index)
+			}
+		}
+	}
+
+	for _, b := range g.Blocks {
+		sort.Ints(b.Pred)
+	}
+
+	return g
+}
+
+// BlockAt returns the index of the block of instruction at addr,
+// or Exit if addr is outside of the program.
+func (g *CFG) BlockAt(addr int) int {
+	if addr < 0 || addr >= len(g.blockOf) {
+		return Exit
+	}
+	return g.blockOf[addr]
+}
+
+// IPReg returns the register bound to the instruction pointer, or -1.
+func (g *CFG) IPReg() int {
+	if g.Arch.IP.IsRegister {
+		return g.Arch.IP.Index
+	}
+	return -1
+}
+
+func (g *CFG) resolveJump(i int) Jump {
+	ip := g.IPReg()
+	inst := g.Prog[i]
+	if wristdev.Halts(inst.Op) {
+		return Jump{Kind: Goto, Target: Exit}
+	}
+	if ip < 0 || !wristdev.Writes(inst.Op) || inst.C != ip {
+		return Jump{Kind: Next}
+	}
+
+	if v, ok := g.constResult(i); ok {
+		return Jump{Kind: Goto, Target: v + 1}
+	}
+
+	// conditional jump: ip += cond
+	ak, bk := inst.Op.Args()
+	if inst.Op.Prefix() == "add" && ak == wristdev.ArgReg && bk == wristdev.ArgReg {
+		var cond int
+		switch {
+		case inst.A == ip:
+			cond = inst.B
+		case inst.B == ip:
+			cond = inst.A
+		default:
+			return Jump{Kind: Indirect}
+		}
+		if i > 0 && isComparison(g.Prog[i-1]) && g.Prog[i-1].C == cond {
+			return Jump{Kind: Branch, Target: i + 2, Cond: cond}
+		}
+	}
+
+	return Jump{Kind: Indirect}
+}
+
+// constResult reports the result of instruction i
+// if it depends only on immediates and the instruction pointer.
+func (g *CFG) constResult(i int) (v int, ok bool) {
+	ip := g.IPReg()
+	inst := g.Prog[i]
+
+	ak, bk := inst.Op.Args()
+	if (ak == wristdev.ArgReg && inst.A != ip) ||
+		(bk == wristdev.ArgReg && inst.B != ip) ||
+		inst.C < 0 || inst.C >= g.Arch.NReg {
+		return 0, false
+	}
+
+	s := wristdev.Arch(g.Arch.NReg).State()
+	if ip >= 0 {
+		s.R[ip] = i
+	}
+	inst.Op.Run(s, inst.A, inst.B, inst.C)
+	return s.R[inst.C], true
+}
+
+func isComparison(inst wristdev.Instruction) bool {
+	switch inst.Op.Prefix() {
+	case "gt", "eq":
+		return true
+	}
+	return false
+}
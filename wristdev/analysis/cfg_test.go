@@ -0,0 +1,179 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tajtiattila/aoc18/wristdev"
+)
+
+// divsumSource is the program of day 19.
+const divsumSource = `#ip 2
+addi 2 16 2
+seti 1 0 4
+seti 1 5 5
+mulr 4 5 1
+eqrr 1 3 1
+addr 1 2 2
+addi 2 1 2
+addr 4 0 0
+addi 5 1 5
+gtrr 5 3 1
+addr 2 1 2
+seti 2 6 2
+addi 4 1 4
+gtrr 4 3 1
+addr 1 2 2
+seti 1 7 2
+mulr 2 2 2
+addi 3 2 3
+mulr 3 3 3
+mulr 2 3 3
+muli 3 11 3
+addi 1 6 1
+mulr 1 2 1
+addi 1 6 1
+addr 3 1 3
+addr 2 0 2
+seti 0 3 2
+setr 2 3 1
+mulr 1 2 1
+addr 2 1 1
+mulr 2 1 1
+muli 1 14 1
+mulr 1 2 1
+addr 3 1 3
+seti 0 9 0
+seti 0 5 2
+`
+
+func buildSource(t *testing.T, src string) *CFG {
+	p, err := wristdev.ParseSource(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Build(p.Arch, p.Inst)
+}
+
+func TestJumps(t *testing.T) {
+	g := buildSource(t, divsumSource)
+
+	tests := []struct {
+		i    int
+		want Jump
+	}{
+		{0, Jump{Kind: Goto, Target: 17}},
+		{1, Jump{Kind: Next}},
+		{5, Jump{Kind: Branch, Target: 7, Cond: 1}},
+		{10, Jump{Kind: Branch, Target: 12, Cond: 1}},
+		{11, Jump{Kind: Goto, Target: 3}},
+		{16, Jump{Kind: Goto, Target: 257}},
+		{25, Jump{Kind: Indirect}},
+		{35, Jump{Kind: Goto, Target: 1}},
+	}
+	for _, tt := range tests {
+		if got := g.Jumps[tt.i]; got != tt.want {
+			t.Errorf("jump %d: got %+v; want %+v", tt.i, got, tt.want)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	g := buildSource(t, divsumSource)
+
+	if len(g.Blocks) != 14 {
+		t.Fatalf("got %d blocks; want 14", len(g.Blocks))
+	}
+
+	for i := range g.Prog {
+		b := g.Blocks[g.BlockAt(i)]
+		if i < b.Start || i >= b.End {
+			t.Errorf("instruction %d in block [%d, %d)", i, b.Start, b.End)
+		}
+	}
+
+	// if r1 goto L7
+	b := g.Blocks[g.BlockAt(3)]
+	if b.Start != 3 || b.End != 6 {
+		t.Errorf("got block [%d, %d); want [3, 6)", b.Start, b.End)
+	}
+	if len(b.Succ) != 2 ||
+		g.Blocks[b.Succ[0]].Start != 7 || g.Blocks[b.Succ[1]].Start != 6 {
+		t.Errorf("got successors %v", b.Succ)
+	}
+
+	if s := g.Blocks[g.BlockAt(16)].Succ; len(s) != 1 || s[0] != Exit {
+		t.Errorf("halt: got successors %v; want exit", s)
+	}
+
+	if s := g.Blocks[g.BlockAt(25)].Succ; len(s) != 0 {
+		t.Errorf("indirect: got successors %v", s)
+	}
+
+	for _, b := range g.Blocks {
+		for _, s := range b.Succ {
+			if s == Exit {
+				continue
+			}
+			found := false
+			for _, p := range g.Blocks[s].Pred {
+				found = found || p == b.Index
+			}
+			if !found {
+				t.Errorf("block %d missing predecessor %d", s, b.Index)
+			}
+		}
+	}
+}
+
+func TestWritePseudo(t *testing.T) {
+	g := buildSource(t, divsumSource)
+
+	var sb strings.Builder
+	if err := g.WritePseudo(&sb); err != nil {
+		t.Fatal(err)
+	}
+	got := sb.String()
+	t.Log("\n" + got)
+
+	for _, want := range []string{
+		"    goto L17\n",
+		"L1:\n",
+		"    do {\n        r5 = 1\n        do {\n",
+		"            if r1 {\n                r0 = r4 + r0\n            }\n",
+		"        } while !r1\n        r4 = r4 + 1\n",
+		"    halt\n",
+		"    goto *(25 + r0 + 1)\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("pseudo-code missing %q", want)
+		}
+	}
+
+	if strings.Contains(got, "L3:") || strings.Contains(got, "L7:") {
+		t.Error("label of structured jump target")
+	}
+}
+
+func TestWriteDot(t *testing.T) {
+	g := buildSource(t, divsumSource)
+
+	var sb strings.Builder
+	if err := g.WriteDot(&sb); err != nil {
+		t.Fatal(err)
+	}
+	got := sb.String()
+
+	for _, want := range []string{
+		"digraph cfg {\n",
+		"\tb0 -> b11;\n",
+		"\tb3 -> b5 [label=\"true\"];\n",
+		"\tb3 -> b4 [label=\"false\"];\n",
+		"\tb10 -> exit;\n",
+		"\texit [shape=oval];\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("dot output missing %q", want)
+		}
+	}
+}
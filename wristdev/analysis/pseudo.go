@@ -0,0 +1,336 @@
+package analysis
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/tajtiattila/aoc18/wristdev"
+)
+
+// binarySym maps operator prefixes to infix symbols.
+var binarySym = map[string]string{
+	"add": "+",
+	"mul": "*",
+	"ban": "&",
+	"bor": "|",
+	"gt":  ">",
+	"eq":  "==",
+}
+
+// RegName returns the name of register r in pseudo-code.
+func (g *CFG) RegName(r int) string {
+	if r == g.IPReg() {
+		return "ip"
+	}
+	return fmt.Sprintf("r%d", r)
+}
+
+// operand formats argument v of kind k of instruction i.
+// Reads of the instruction pointer are shown as the constant i.
+func (g *CFG) operand(i int, k wristdev.ArgKind, v int) string {
+	if k == wristdev.ArgReg {
+		if v == g.IPReg() {
+			return fmt.Sprint(i)
+		}
+		return g.RegName(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// Expr returns the value computed by instruction i as an expression.
+func (g *CFG) Expr(i int) string {
+	inst := g.Prog[i]
+	ak, bk := inst.Op.Args()
+
+	if ak == wristdev.ArgReg || bk == wristdev.ArgReg {
+		if v, ok := g.constResult(i); ok {
+			return fmt.Sprint(v)
+		}
+	}
+
+	a := g.operand(i, ak, inst.A)
+	b := g.operand(i, bk, inst.B)
+
+	pfx := inst.Op.Prefix()
+	if pfx == "set" {
+		return a
+	}
+	if sym, ok := binarySym[pfx]; ok {
+		return a + " " + sym + " " + b
+	}
+
+	var args []string
+	if ak != wristdev.ArgUnused {
+		args = append(args, a)
+	}
+	if bk != wristdev.ArgUnused {
+		args = append(args, b)
+	}
+	return pfx + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Label returns the label of the instruction at addr.
+func Label(addr int) string {
+	return fmt.Sprintf("L%d", addr)
+}
+
+// Stmt returns instruction i as a pseudo-code statement.
+func (g *CFG) Stmt(i int) string {
+	j := g.Jumps[i]
+	switch j.Kind {
+	case Goto:
+		if g.BlockAt(j.Target) == Exit {
+			return "halt"
+		}
+		return "goto " + Label(j.Target)
+	case Branch:
+		return fmt.Sprintf("if %s goto %s", g.RegName(j.Cond), Label(j.Target))
+	case Indirect:
+		return fmt.Sprintf("goto *(%s + 1)", g.Expr(i))
+	}
+	if !wristdev.Writes(g.Prog[i].Op) {
+		return g.Expr(i)
+	}
+	return fmt.Sprintf("%s = %s", g.RegName(g.Prog[i].C), g.Expr(i))
+}
+
+// construct is a structured loop or if statement.
+type construct struct {
+	loop bool
+
+	head int // loop header, or block with the if condition
+	tail int // block with the loop condition, or last block of if body
+	skip int // block with the goto replaced by the construct
+
+	cond int // condition register
+}
+
+// region returns the range of blocks the construct spans.
+func (c construct) region() (lo, hi int) {
+	if c.loop {
+		return c.head, c.skip
+	}
+	return c.skip, c.tail
+}
+
+// constructs finds structured loops and if statements in g.
+//
+// Both are formed by a Branch over a Goto. A backward Goto makes
+// a loop, its body executed while the branch is not taken:
+//
+//	L3: ...
+//	    if r1 goto L12
+//	    goto L3
+//	L12:
+//
+// A forward Goto makes an if statement with the body
+// executed when the branch is taken:
+//
+//	    if r1 goto L7
+//	    goto L8
+//	L7: ...
+//	L8:
+func (g *CFG) constructs() []construct {
+	var found []construct
+	for _, b := range g.Blocks {
+		if b.Jump.Kind != Branch || b.Index+1 >= len(g.Blocks) {
+			continue
+		}
+
+		f := g.Blocks[b.Index+1]
+		if f.End-f.Start != 1 || f.Jump.Kind != Goto || len(f.Pred) != 1 {
+			continue
+		}
+
+		target := g.BlockAt(f.Jump.Target)
+		var c construct
+		switch {
+		case target == Exit:
+			continue
+		case target <= b.Index:
+			c = construct{loop: true, head: target, tail: b.Index}
+		case target > f.Index+1:
+			c = construct{head: b.Index, tail: target - 1}
+		default:
+			continue
+		}
+		c.skip, c.cond = f.Index, b.Jump.Cond
+
+		// blocks inside may be entered only from within
+		lo, hi := c.region()
+		if c.loop {
+			lo++
+		}
+		ok := true
+		for k := lo; k <= hi && ok; k++ {
+			for _, p := range g.Blocks[k].Pred {
+				if p < c.head || p > hi {
+					ok = false
+				}
+			}
+		}
+		if ok {
+			found = append(found, c)
+		}
+	}
+
+	// keep properly nested constructs, outer ones first
+	sort.SliceStable(found, func(i, j int) bool {
+		ilo, ihi := found[i].region()
+		jlo, jhi := found[j].region()
+		if ilo != jlo {
+			return ilo < jlo
+		}
+		return ihi > jhi
+	})
+
+	var res []construct
+	for _, c := range found {
+		lo, hi := c.region()
+		ok := true
+		for _, d := range res {
+			dlo, dhi := d.region()
+			disjoint := hi < dlo || dhi < lo
+			nested := dlo <= lo && hi <= dhi
+			if !disjoint && !nested {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// WritePseudo writes the program as pseudo-code to w,
+// using do-while loops and if statements where possible.
+func (g *CFG) WritePseudo(w io.Writer) error {
+	cs := g.constructs()
+
+	loopsAt := make(map[int]int) // header -> number of loops
+	var (
+		loopTail = make(map[int]construct)
+		ifHead   = make(map[int]construct)
+		closeAt  = make(map[int]int)
+		skip     = make(map[int]bool)
+	)
+	for _, c := range cs {
+		skip[c.skip] = true
+		if c.loop {
+			loopsAt[c.head]++
+			loopTail[c.tail] = c
+		} else {
+			ifHead[c.head] = c
+			closeAt[c.tail]++
+		}
+	}
+
+	// blocks needing labels are explicit jump targets
+	label := make(map[int]bool)
+	for _, b := range g.Blocks {
+		if skip[b.Index] {
+			continue
+		}
+		_, tail := loopTail[b.Index]
+		_, head := ifHead[b.Index]
+		if b.Jump.Kind == Goto || (b.Jump.Kind == Branch && !tail && !head) {
+			label[g.BlockAt(b.Jump.Target)] = true
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	indent := 1
+	line := func(s string) {
+		bw.WriteString(strings.Repeat("    ", indent))
+		bw.WriteString(s)
+		bw.WriteByte('\n')
+	}
+
+	for _, b := range g.Blocks {
+		if skip[b.Index] {
+			continue
+		}
+
+		if label[b.Index] {
+			fmt.Fprintf(bw, "%s:\n", Label(b.Start))
+		}
+
+		for n := loopsAt[b.Index]; n > 0; n-- {
+			line("do {")
+			indent++
+		}
+
+		for i := b.Start; i < b.End-1; i++ {
+			line(g.Stmt(i))
+		}
+
+		last := b.End - 1
+		if c, ok := loopTail[b.Index]; ok {
+			indent--
+			line(fmt.Sprintf("} while !%s", g.RegName(c.cond)))
+		} else if c, ok := ifHead[b.Index]; ok {
+			line(fmt.Sprintf("if %s {", g.RegName(c.cond)))
+			indent++
+		} else {
+			line(g.Stmt(last))
+		}
+
+		for n := closeAt[b.Index]; n > 0; n-- {
+			indent--
+			line("}")
+		}
+	}
+
+	return bw.Flush()
+}
+
+// WriteDot writes the control flow graph to w in Graphviz DOT format.
+func (g *CFG) WriteDot(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph cfg {")
+	fmt.Fprintln(bw, "\tnode [shape=box fontname=monospace];")
+
+	exit := false
+	node := func(i int) string {
+		if i == Exit {
+			exit = true
+			return "exit"
+		}
+		return fmt.Sprintf("b%d", i)
+	}
+
+	for _, b := range g.Blocks {
+		var lbl strings.Builder
+		fmt.Fprintf(&lbl, "%s:\\l", Label(b.Start))
+		for i := b.Start; i < b.End; i++ {
+			fmt.Fprintf(&lbl, "%3d  %s\\l", i, dotEscape(g.Stmt(i)))
+		}
+		fmt.Fprintf(bw, "\t%s [label=\"%s\"];\n", node(b.Index), lbl.String())
+
+		for k, s := range b.Succ {
+			attr := ""
+			if b.Jump.Kind == Branch {
+				attr = ` [label="false"]`
+				if k == 0 {
+					attr = ` [label="true"]`
+				}
+			}
+			fmt.Fprintf(bw, "\t%s -> %s%s;\n", node(b.Index), node(s), attr)
+		}
+	}
+
+	if exit {
+		fmt.Fprintln(bw, "\texit [shape=oval];")
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
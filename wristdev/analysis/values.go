@@ -0,0 +1,669 @@
+package analysis
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tajtiattila/aoc18/wristdev"
+)
+
+// Value is the abstract value of a register: an interval and the
+// known bits of its possible values, and the input registers
+// it depends on.
+type Value struct {
+	// Lo and Hi are the bounds of the values.
+	// math.MinInt64 and math.MaxInt64 mean unbounded.
+	Lo, Hi int
+
+	Known uint64 // mask of known bits
+	Bits  uint64 // values of the known bits
+
+	// Deps holds the registers whose initial value
+	// the value is computed from.
+	Deps Regs
+}
+
+const (
+	minInf = math.MinInt64
+	maxInf = math.MaxInt64
+
+	signBit = 1 << 63
+)
+
+// Unknown returns a value without known bounds or bits.
+func Unknown() Value {
+	return Value{Lo: minInf, Hi: maxInf}
+}
+
+// Const returns the value v.
+func Const(v int) Value {
+	return Range(v, v)
+}
+
+// Range returns the values from lo to hi inclusive.
+func Range(lo, hi int) Value {
+	return Value{Lo: lo, Hi: hi}.reduce()
+}
+
+// IsConst reports if v has a single possible value.
+func (v Value) IsConst() (int, bool) {
+	return v.Lo, v.Lo == v.Hi
+}
+
+// Contains reports if x is a possible value of v.
+func (v Value) Contains(x int) bool {
+	return v.Lo <= x && x <= v.Hi && uint64(x)&v.Known == v.Bits
+}
+
+// reduce tightens the bounds and known bits of v using each other.
+func (v Value) reduce() Value {
+	v.Bits &= v.Known
+	for pass := 0; pass < 2; pass++ {
+		if v.Lo == v.Hi {
+			v.Known, v.Bits = ^uint64(0), uint64(v.Lo)
+			return v
+		}
+
+		if v.Lo >= 0 {
+			// leading zeros
+			m := ^(uint64(1)<<uint(bits.Len64(uint64(v.Hi))) - 1)
+			v.Known |= m
+			v.Bits &^= m
+		} else if v.Hi < 0 {
+			v.Known |= signBit
+			v.Bits |= signBit
+		}
+
+		if v.Known&signBit != 0 {
+			// ordering of values with the same sign
+			// is that of their bits
+			lo, hi := int(v.Bits), int(v.Bits|^v.Known)
+			if lo > v.Lo {
+				v.Lo = lo
+			}
+			if hi < v.Hi {
+				v.Hi = hi
+			}
+		}
+	}
+	return v
+}
+
+// join returns the union of the values of a and b.
+func join(a, b Value) Value {
+	k := a.Known & b.Known &^ (a.Bits ^ b.Bits)
+	v := Value{
+		Lo:    minInt(a.Lo, b.Lo),
+		Hi:    maxInt(a.Hi, b.Hi),
+		Known: k,
+		Bits:  a.Bits & k,
+		Deps:  a.Deps | b.Deps,
+	}
+	return v.reduce()
+}
+
+// widen drops bounds of v growing since old,
+// so that loops reach a fixed point.
+// Bounds implied by known bits are kept, these
+// may only grow as many times as there are bits.
+func widen(old, v Value) Value {
+	if v.Lo < old.Lo {
+		v.Lo = minInf
+	}
+	if v.Hi > old.Hi {
+		v.Hi = maxInf
+	}
+	return v.reduce()
+}
+
+func (v Value) String() string {
+	var s string
+	switch {
+	case v.Lo == v.Hi:
+		s = fmt.Sprint(v.Lo)
+	case v.Lo == minInf && v.Hi == maxInf:
+		s = "?"
+	default:
+		s = "[" + boundString(v.Lo) + "," + boundString(v.Hi) + "]"
+	}
+
+	// known bits not implied by the bounds
+	if v.Lo != v.Hi {
+		implied := Value{Lo: v.Lo, Hi: v.Hi}.reduce().Known
+		if m := v.Known &^ implied; m != 0 {
+			s += fmt.Sprintf(" &%#x=%#x", m, v.Bits&m)
+		}
+	}
+
+	if v.Deps != 0 {
+		s += " " + v.Deps.String()
+	}
+	return s
+}
+
+func boundString(x int) string {
+	switch x {
+	case minInf:
+		return "-inf"
+	case maxInf:
+		return "+inf"
+	}
+	return fmt.Sprint(x)
+}
+
+// Regs is a set of registers.
+type Regs uint64
+
+// Has reports if r contains register reg.
+func (r Regs) Has(reg int) bool {
+	return 0 <= reg && reg < 64 && r&(1<<uint(reg)) != 0
+}
+
+// List returns the registers of r in increasing order.
+func (r Regs) List() []int {
+	var v []int
+	for reg := 0; reg < 64; reg++ {
+		if r.Has(reg) {
+			v = append(v, reg)
+		}
+	}
+	return v
+}
+
+func (r Regs) String() string {
+	var v []string
+	for _, reg := range r.List() {
+		v = append(v, fmt.Sprintf("r%d", reg))
+	}
+	return "{" + strings.Join(v, ",") + "}"
+}
+
+// Values is the result of the abstract interpretation of a program.
+type Values struct {
+	Arch *wristdev.Architecture
+	Prog []wristdev.Instruction
+
+	// In holds the register values before each instruction,
+	// or nil for instructions never executed.
+	In [][]Value
+
+	// Result holds the value written by each executed instruction.
+	Result []Value
+
+	// Succ holds the possible addresses after each
+	// executed instruction, with Exit for halting.
+	Succ [][]int
+
+	// Inputs holds the registers whose initial value is used.
+	Inputs Regs
+}
+
+// widenAfter is the number of updates of the values before
+// an instruction after which growing bounds are dropped.
+const widenAfter = 3
+
+// AnalyzeValues runs abstract interpretation of prog running on arch,
+// starting with the register values in entry.
+// Registers missing from entry are unknown.
+//
+// Dependencies on input registers follow data flow only,
+// values computed in branches depending on an input
+// are not marked depending on it.
+func AnalyzeValues(arch *wristdev.Architecture, prog []wristdev.Instruction, entry []Value) *Values {
+	v := &Values{
+		Arch:   arch,
+		Prog:   prog,
+		In:     make([][]Value, len(prog)),
+		Result: make([]Value, len(prog)),
+		Succ:   make([][]int, len(prog)),
+	}
+	if len(prog) == 0 {
+		return v
+	}
+
+	start := make([]Value, arch.NReg)
+	for r := range start {
+		start[r] = Unknown()
+		if r < len(entry) {
+			start[r] = entry[r]
+		}
+		start[r].Deps |= 1 << uint(r)
+	}
+
+	updates := make([]int, len(prog))
+	queued := make([]bool, len(prog))
+	work := []int{0}
+	v.In[0], queued[0] = start, true
+
+	for len(work) != 0 {
+		i := work[0]
+		work = work[1:]
+		queued[i] = false
+
+		out, succ := v.step(i)
+		for _, t := range succ {
+			if t == Exit {
+				continue
+			}
+
+			if !v.merge(t, out, updates[t] >= widenAfter) {
+				continue
+			}
+			updates[t]++
+			if !queued[t] {
+				work = append(work, t)
+				queued[t] = true
+			}
+		}
+	}
+
+	// final pass over the fixed point
+	for i, in := range v.In {
+		if in == nil {
+			continue
+		}
+		_, v.Succ[i] = v.step(i)
+		for _, r := range v.reads(i) {
+			v.Inputs |= v.state(i)[r].Deps
+		}
+	}
+
+	return v
+}
+
+// merge merges out into the values before instruction t,
+// and reports if they changed.
+func (v *Values) merge(t int, out []Value, widening bool) bool {
+	old := v.In[t]
+	if old == nil {
+		v.In[t] = append([]Value(nil), out...)
+		return true
+	}
+
+	changed := false
+	for r := range old {
+		x := join(old[r], out[r])
+		if widening {
+			x = widen(old[r], x)
+		}
+		if x != old[r] {
+			old[r] = x
+			changed = true
+		}
+	}
+	return changed
+}
+
+// state returns the register values when instruction i is executed.
+func (v *Values) state(i int) []Value {
+	s := append([]Value(nil), v.In[i]...)
+	if ip := v.ipReg(); ip >= 0 {
+		s[ip] = Const(i)
+	}
+	return s
+}
+
+func (v *Values) ipReg() int {
+	if v.Arch.IP.IsRegister {
+		return v.Arch.IP.Index
+	}
+	return -1
+}
+
+// reads returns the registers read by instruction i.
+func (v *Values) reads(i int) []int {
+	inst := v.Prog[i]
+	ak, bk := inst.Op.Args()
+	var regs []int
+	if ak == wristdev.ArgReg {
+		regs = append(regs, inst.A)
+	}
+	if bk == wristdev.ArgReg {
+		regs = append(regs, inst.B)
+	}
+
+	var valid []int
+	for _, r := range regs {
+		if 0 <= r && r < v.Arch.NReg {
+			valid = append(valid, r)
+		}
+	}
+	return valid
+}
+
+// step executes instruction i, and returns the values after it
+// and the possible addresses of the next instruction.
+func (v *Values) step(i int) ([]Value, []int) {
+	s := v.state(i)
+	inst := v.Prog[i]
+
+	if wristdev.Halts(inst.Op) {
+		return s, []int{Exit}
+	}
+
+	res := eval(inst, s)
+	v.Result[i] = res
+	writes := wristdev.Writes(inst.Op)
+	if writes && 0 <= inst.C && inst.C < len(s) {
+		s[inst.C] = res
+	}
+
+	ip := v.ipReg()
+	if ip < 0 || !writes || inst.C != ip {
+		return s, []int{v.addr(i + 1)}
+	}
+
+	var succ []int
+	add := func(t int) {
+		t = v.addr(t)
+		for _, x := range succ {
+			if x == t {
+				return
+			}
+		}
+		succ = append(succ, t)
+	}
+
+	n := len(v.Prog)
+	if res.Lo > minInf && res.Hi < maxInf && uint64(res.Hi)-uint64(res.Lo) <= uint64(n) {
+		for x := res.Lo; ; x++ {
+			if res.Contains(x) {
+				add(x + 1)
+			}
+			if x == res.Hi {
+				break
+			}
+		}
+	} else {
+		for t := 0; t < n; t++ {
+			if res.Contains(t - 1) {
+				add(t)
+			}
+		}
+		add(Exit)
+	}
+	sort.Ints(succ)
+	return s, succ
+}
+
+// addr returns address t, or Exit if it is outside of the program.
+func (v *Values) addr(t int) int {
+	if t < 0 || t >= len(v.Prog) {
+		return Exit
+	}
+	return t
+}
+
+// eval returns the value written by inst with register values s.
+func eval(inst wristdev.Instruction, s []Value) Value {
+	arg := func(k wristdev.ArgKind, x int) Value {
+		switch k {
+		case wristdev.ArgReg:
+			if 0 <= x && x < len(s) {
+				return s[x]
+			}
+			return Const(0)
+		case wristdev.ArgImmediate:
+			return Const(x)
+		}
+		return Value{}
+	}
+
+	ak, bk := inst.Op.Args()
+	a, b := arg(ak, inst.A), arg(bk, inst.B)
+	deps := a.Deps | b.Deps
+
+	prefix := inst.Op.Prefix()
+	if wristdev.Standard.Op(inst.Op.Name()) == nil {
+		// operator of another instruction set
+		prefix = ""
+	}
+
+	var res Value
+	switch prefix {
+	case "add":
+		res = addValues(a, b)
+	case "mul":
+		res = mulValues(a, b)
+	case "ban":
+		res = banValues(a, b)
+	case "bor":
+		res = borValues(a, b)
+	case "set":
+		res = a
+	case "gt":
+		res = compare(a.Lo > b.Hi, a.Hi <= b.Lo)
+	case "eq":
+		_, ac := a.IsConst()
+		_, bc := b.IsConst()
+		differ := a.Hi < b.Lo || b.Hi < a.Lo || (a.Known&b.Known)&(a.Bits^b.Bits) != 0
+		res = compare(ac && bc && a.Lo == b.Lo, differ)
+	default:
+		res = Unknown()
+	}
+
+	res.Deps = deps
+	return res
+}
+
+func compare(isTrue, isFalse bool) Value {
+	switch {
+	case isTrue:
+		return Const(1)
+	case isFalse:
+		return Const(0)
+	}
+	return Range(0, 1)
+}
+
+// lowBits returns the value with the low bits of a op b
+// known as far as the low bits of both a and b are known.
+func lowBits(a, b Value, op func(x, y uint64) uint64) Value {
+	n := bits.TrailingZeros64(^(a.Known & b.Known))
+	m := uint64(1)<<uint(n) - 1
+	if n == 64 {
+		m = ^uint64(0)
+	}
+	v := Unknown()
+	v.Known, v.Bits = m, op(a.Bits, b.Bits)&m
+	return v
+}
+
+func addValues(a, b Value) Value {
+	v := lowBits(a, b, func(x, y uint64) uint64 { return x + y })
+	lo, okl := addInt(a.Lo, b.Lo)
+	hi, okh := addInt(a.Hi, b.Hi)
+	if okl && okh {
+		v.Lo, v.Hi = lo, hi
+	}
+	return v.reduce()
+}
+
+func mulValues(a, b Value) Value {
+	v := lowBits(a, b, func(x, y uint64) uint64 { return x * y })
+	lo, hi := maxInf, minInf
+	for _, x := range []int{a.Lo, a.Hi} {
+		for _, y := range []int{b.Lo, b.Hi} {
+			p, ok := mulInt(x, y)
+			if !ok {
+				return v.reduce()
+			}
+			lo, hi = minInt(lo, p), maxInt(hi, p)
+		}
+	}
+	v.Lo, v.Hi = lo, hi
+	return v.reduce()
+}
+
+func banValues(a, b Value) Value {
+	// known zeros of either, and known ones of both
+	k := (a.Known & b.Known) | (a.Known &^ a.Bits) | (b.Known &^ b.Bits)
+	v := Value{Lo: minInf, Hi: maxInf, Known: k, Bits: a.Bits & b.Bits & k}
+	switch {
+	case a.Lo >= 0 && b.Lo >= 0:
+		v.Lo, v.Hi = 0, minInt(a.Hi, b.Hi)
+	case a.Lo >= 0:
+		v.Lo, v.Hi = 0, a.Hi
+	case b.Lo >= 0:
+		v.Lo, v.Hi = 0, b.Hi
+	}
+	return v.reduce()
+}
+
+func borValues(a, b Value) Value {
+	// known ones of either, and known zeros of both
+	k := (a.Known & b.Known) | (a.Known & a.Bits) | (b.Known & b.Bits)
+	v := Value{Lo: minInf, Hi: maxInf, Known: k, Bits: (a.Bits | b.Bits) & k}
+	if a.Lo >= 0 && b.Lo >= 0 {
+		v.Lo = maxInt(a.Lo, b.Lo)
+	}
+	return v.reduce()
+}
+
+// addInt returns x+y, or false if the sum of finite bounds
+// overflows. Infinite bounds stay infinite, the sum of
+// opposite infinite bounds is false.
+func addInt(x, y int) (int, bool) {
+	switch {
+	case isInf(x) && isInf(y):
+		return x, x == y
+	case isInf(x):
+		return x, true
+	case isInf(y):
+		return y, true
+	}
+	s := x + y
+	if (s > x) != (y > 0) {
+		return 0, false
+	}
+	return s, true
+}
+
+// mulInt returns x*y, or false if the product overflows.
+// Infinite bounds stay infinite when multiplied by positive
+// bounds, other products of infinite bounds are false.
+func mulInt(x, y int) (int, bool) {
+	if x == 0 || y == 0 {
+		return 0, true
+	}
+	if (x == maxInf && y > 0) || (y == maxInf && x > 0) {
+		return maxInf, true
+	}
+	if isInf(x) || isInf(y) {
+		return 0, false
+	}
+	p := x * y
+	if p/y != x {
+		return 0, false
+	}
+	return p, true
+}
+
+func isInf(x int) bool {
+	return x == minInf || x == maxInf
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Dead returns the addresses of instructions never executed.
+func (v *Values) Dead() []int {
+	var dead []int
+	for i, in := range v.In {
+		if in == nil {
+			dead = append(dead, i)
+		}
+	}
+	return dead
+}
+
+// ValueBranch is an instruction with more than one possible successor.
+type ValueBranch struct {
+	Addr int
+	Succ []int // possible next addresses, Exit for halting
+
+	// Deps holds the input registers the target is computed from.
+	Deps Regs
+}
+
+// Branches returns the executed instructions
+// with more than one possible successor.
+func (v *Values) Branches() []ValueBranch {
+	var br []ValueBranch
+	for i, succ := range v.Succ {
+		if len(succ) > 1 {
+			br = append(br, ValueBranch{
+				Addr: i,
+				Succ: succ,
+				Deps: v.Result[i].Deps,
+			})
+		}
+	}
+	return br
+}
+
+// BranchesOn returns the branches depending on register reg.
+func (v *Values) BranchesOn(reg int) []ValueBranch {
+	var br []ValueBranch
+	for _, b := range v.Branches() {
+		if b.Deps.Has(reg) {
+			br = append(br, b)
+		}
+	}
+	return br
+}
+
+// WriteReport writes the inputs, branches and dead instructions
+// of the program, and the value computed by each instruction.
+func (v *Values) WriteReport(w io.Writer) error {
+	fmt.Fprintf(w, "inputs: %v\n", v.Inputs)
+
+	fmt.Fprintln(w, "branches:")
+	for _, b := range v.Branches() {
+		var succ []string
+		for _, t := range b.Succ {
+			if t == Exit {
+				succ = append(succ, "exit")
+			} else {
+				succ = append(succ, Label(t))
+			}
+		}
+		fmt.Fprintf(w, "  %3d -> %s", b.Addr, strings.Join(succ, " "))
+		if b.Deps != 0 {
+			fmt.Fprintf(w, " depends on %v", b.Deps)
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintf(w, "dead: %v\n", v.Dead())
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for i, inst := range v.Prog {
+		if v.In[i] == nil {
+			fmt.Fprintf(tw, "%3d\t%v\t; dead\n", i, inst)
+			continue
+		}
+		if !wristdev.Writes(inst.Op) {
+			fmt.Fprintf(tw, "%3d\t%v\t;\n", i, inst)
+			continue
+		}
+		fmt.Fprintf(tw, "%3d\t%v\t; r%d = %v\n", i, inst, inst.C, v.Result[i])
+	}
+	return tw.Flush()
+}
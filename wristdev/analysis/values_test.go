@@ -0,0 +1,169 @@
+package analysis
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tajtiattila/aoc18/wristdev"
+)
+
+// magicSource is the program of day 21.
+const magicSource = `#ip 5
+seti 123 0 3
+bani 3 456 3
+eqri 3 72 3
+addr 3 5 5
+seti 0 0 5
+seti 0 9 3
+bori 3 65536 1
+seti 14906355 8 3
+bani 1 255 4
+addr 3 4 3
+bani 3 16777215 3
+muli 3 65899 3
+bani 3 16777215 3
+gtir 256 1 4
+addr 4 5 5
+addi 5 1 5
+seti 27 8 5
+seti 0 4 4
+addi 4 1 2
+muli 2 256 2
+gtrr 2 1 2
+addr 2 5 5
+addi 5 1 5
+seti 25 1 5
+addi 4 1 4
+seti 17 2 5
+setr 4 9 1
+seti 7 0 5
+eqrr 3 0 4
+addr 4 5 5
+seti 5 3 5
+`
+
+func TestValueOps(t *testing.T) {
+	tests := []struct {
+		got  Value
+		want string
+	}{
+		{Const(5), "5"},
+		{Unknown(), "?"},
+		{Range(-3, 7), "[-3,7]"},
+		{join(Const(4), Const(6)), "[4,6] &0x5=0x4"},
+		{banValues(Unknown(), Const(0xff)), "[0,255]"},
+		{borValues(Range(0, 3), Const(0x100)), "[256,259] &0x1fc=0x100"},
+		{addValues(Range(1, 2), Range(10, 20)), "[11,22]"},
+		{addValues(Range(1, maxInf), Const(1)), "[2,+inf]"},
+		{addValues(Range(minInf, 5), Range(-1, maxInf)), "?"},
+		{addValues(Range(minInf, 5), Const(1)), "[-inf,6]"},
+		{mulValues(Range(-2, 3), Range(4, 5)), "[-10,15]"},
+		{mulValues(Const(3), Range(0, 7)), "[0,21]"},
+		{mulValues(Range(0, maxInf), Range(2, 3)), "[0,+inf]"},
+		{mulValues(Range(1, maxInf), Const(2)), "[2,+inf]"},
+		{mulValues(Range(-1, maxInf), Const(2)), "[-2,+inf]"},
+		{mulValues(Range(minInf, 1), Const(2)), "?"},
+		{widen(Range(0, 3), Range(0, 4)), "[0,7]"},
+		{widen(Range(0, 3), Range(-1, 3)), "[-inf,3]"},
+	}
+	for i, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%d: got %s; want %s", i, got, tt.want)
+		}
+	}
+
+	v := banValues(Unknown(), Const(0xf0))
+	if !v.Contains(0x30) || v.Contains(0x31) || v.Contains(-1) {
+		t.Errorf("invalid contents of %v", v)
+	}
+}
+
+func TestAnalyzeValues(t *testing.T) {
+	g := buildSource(t, magicSource)
+	v := AnalyzeValues(g.Arch, g.Prog, nil)
+
+	if got := v.Inputs.List(); !reflect.DeepEqual(got, []int{0}) {
+		t.Errorf("got inputs %v; want r0", got)
+	}
+	if got := v.Dead(); !reflect.DeepEqual(got, []int{4}) {
+		t.Errorf("got dead instructions %v; want 4", got)
+	}
+
+	br := v.BranchesOn(0)
+	if len(br) != 1 || br[0].Addr != 29 || !reflect.DeepEqual(br[0].Succ, []int{Exit, 30}) {
+		t.Errorf("got branches on r0 %+v; want 29 -> exit, 30", br)
+	}
+	if n := len(v.Branches()); n != 3 {
+		t.Errorf("got %d branches; want 3", n)
+	}
+
+	if got := v.Result[12].String(); got != "[0,16777215]" {
+		t.Errorf("got r3 = %s at 12; want 24 bits", got)
+	}
+
+	var buf bytes.Buffer
+	if err := v.WriteReport(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"inputs: {r0}", "29 -> exit L30 depends on {r0}", "dead: [4]"} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("report lacks %q:\n%s", s, buf.String())
+		}
+	}
+}
+
+func TestAnalyzeValuesEntry(t *testing.T) {
+	g := buildSource(t, divsumSource)
+
+	// r0 selects the part of the puzzle
+	entry := []Value{Range(0, 1), Const(0), Const(0), Const(0), Const(0), Const(0)}
+	v := AnalyzeValues(g.Arch, g.Prog, entry)
+
+	br := v.BranchesOn(0)
+	if len(br) != 1 || br[0].Addr != 25 || !reflect.DeepEqual(br[0].Succ, []int{26, 27}) {
+		t.Errorf("got branches on r0 %+v; want 25 -> 26, 27", br)
+	}
+	if got := v.Result[33].String(); got != "10551374 {r1,r3}" {
+		t.Errorf("got r3 = %s at 33", got)
+	}
+	if len(v.Dead()) != 0 {
+		t.Errorf("got dead instructions %v", v.Dead())
+	}
+
+	// unknown r0 may jump anywhere
+	v = AnalyzeValues(g.Arch, g.Prog, nil)
+	if n := len(v.Succ[25]); n != len(g.Prog)+1 {
+		t.Errorf("got %d successors of indirect jump; want %d", n, len(g.Prog)+1)
+	}
+}
+
+func TestVoidOperators(t *testing.T) {
+	const src = `#ip 3
+seti 5 0 0
+out 0
+halt 0 0 3 ; padded operands
+addi 0 1 0 ; not reached
+`
+	p, err := wristdev.Extended.ParseSource(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := Build(p.Arch, p.Inst)
+	if j := g.Jumps[1]; j.Kind != Next {
+		t.Errorf("got jump %+v for out", j)
+	}
+	if j := g.Jumps[2]; j.Kind != Goto || g.BlockAt(j.Target) != Exit {
+		t.Errorf("got jump %+v for halt", j)
+	}
+
+	v := AnalyzeValues(p.Arch, p.Inst, nil)
+	if c, ok := v.In[2][0].IsConst(); !ok || c != 5 {
+		t.Errorf("got r0=%v after out; want 5", v.In[2][0])
+	}
+	if got := v.Dead(); !reflect.DeepEqual(got, []int{3}) {
+		t.Errorf("got dead %v; want [3]", got)
+	}
+}
@@ -0,0 +1,385 @@
+package wristdev
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Assemble assembles a program from symbolic source read from r.
+//
+// The source may use the syntax of ParseSource, and in addition:
+//
+//	name:              label for the address of the next instruction
+//	#reg name N        name register N
+//	#const name value  define a constant
+//	#scratch reg       register clobbered by jz
+//	#link reg          register holding the return address of call
+//
+// Register operands are register names, rN or numbers.
+// The register bound by #ip is also called ip.
+// Immediate operands are numbers, constants or labels.
+//
+// Pseudo-instructions are expanded to IP register arithmetic,
+// therefore they need the #ip directive:
+//
+//	jmp label      jump to label
+//	jz reg label   jump to label if reg is zero
+//	call label     jump to label, storing the return address in #link
+//	ret            return after the last call
+//
+// Calls don't nest as there is only a single link register.
+//
+// Parse errors are reported as *SyntaxError.
+func Assemble(r io.Reader) (*Program, error) {
+	return Standard.Assemble(r)
+}
+
+// Assemble is like the Assemble function,
+// but accepts the operators of set.
+func (set *InstructionSet) Assemble(r io.Reader) (*Program, error) {
+	a := &assembler{
+		p: &Program{
+			Arch: Arch(SourceRegisters).WithSet(set),
+		},
+		set:     set,
+		regs:    make(map[string]int),
+		imms:    make(map[string]int),
+		scratch: -1,
+		link:    -1,
+	}
+	for i := 0; i < a.p.Arch.NReg; i++ {
+		a.regs["r"+strconv.Itoa(i)] = i
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		if err := a.scanLine(splitSourceLine(lineno, scanner.Text())); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read source")
+	}
+
+	for _, st := range a.stmts {
+		if err := a.emit(st); err != nil {
+			return nil, err
+		}
+	}
+
+	return a.p, nil
+}
+
+type assembler struct {
+	p   *Program
+	set *InstructionSet
+
+	regs map[string]int // register names
+	imms map[string]int // constants and labels
+
+	scratch, link int // registers used by pseudo-instructions, or -1
+
+	addr  int // address of next statement
+	stmts []asmStmt
+}
+
+// asmStmt is an instruction or pseudo-instruction
+// starting at token op of line l.
+type asmStmt struct {
+	l  *sourceLine
+	op int
+
+	addr int
+}
+
+// pseudoSize is the number of instructions
+// pseudo-instructions are expanded to.
+var pseudoSize = map[string]int{
+	"jmp":  1,
+	"jz":   4,
+	"call": 2,
+	"ret":  1,
+}
+
+// scanLine records statements, labels and directives of l.
+func (a *assembler) scanLine(l *sourceLine) error {
+	i := 0
+	for ; i < len(l.toks) && strings.HasSuffix(l.toks[i].s, ":"); i++ {
+		name := strings.TrimSuffix(l.toks[i].s, ":")
+		if err := a.define(l, i, name); err != nil {
+			return err
+		}
+		a.imms[name] = a.addr
+	}
+
+	if i == len(l.toks) {
+		return nil
+	}
+
+	name := l.toks[i].s
+	if strings.HasPrefix(name, "#") {
+		return a.directive(l, i)
+	}
+
+	n := pseudoSize[name]
+	if n == 0 {
+		if a.set.Op(name) == nil {
+			return l.errAt(i, "unknown op %q", name)
+		}
+		n = 1
+	}
+
+	a.stmts = append(a.stmts, asmStmt{l: l, op: i, addr: a.addr})
+	a.addr += n
+	return nil
+}
+
+// define checks name at token i of l for a new symbol.
+func (a *assembler) define(l *sourceLine, i int, name string) error {
+	if !isIdent(name) {
+		return l.errAt(i, "invalid name %q", name)
+	}
+	_, isReg := a.regs[name]
+	_, isImm := a.imms[name]
+	if isReg || isImm || pseudoSize[name] != 0 || a.set.Op(name) != nil {
+		return l.errAt(i, "%s redefined", name)
+	}
+	return nil
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case i > 0 && '0' <= r && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// directive handles the directive at token i of l.
+func (a *assembler) directive(l *sourceLine, i int) error {
+	name := l.toks[i].s
+
+	nargs := 1
+	switch name {
+	case "#ip", "#scratch", "#link":
+	case "#reg", "#const":
+		nargs = 2
+	default:
+		return l.errAt(i, "unknown directive %q", name)
+	}
+
+	if n := len(l.toks) - i - 1; n < nargs {
+		return l.errAt(len(l.toks), "%s needs %d operands", name, nargs)
+	} else if n > nargs {
+		return l.errAt(i+1+nargs, "unexpected %q", l.toks[i+1+nargs].s)
+	}
+
+	switch name {
+	case "#ip":
+		if a.addr != 0 {
+			return l.errAt(i, "#ip directive after instructions")
+		}
+		reg, err := a.reg(l, i+1)
+		if err != nil {
+			return err
+		}
+		if a.p.Arch.IP.IsRegister {
+			return l.errAt(i, "duplicate #ip directive")
+		}
+		a.p.Arch = ArchWithIP(a.p.Arch.NReg, reg).WithSet(a.set)
+		if _, ok := a.regs["ip"]; !ok {
+			a.regs["ip"] = reg
+		}
+
+	case "#scratch", "#link":
+		reg, err := a.reg(l, i+1)
+		if err != nil {
+			return err
+		}
+		if name == "#scratch" {
+			a.scratch = reg
+		} else {
+			a.link = reg
+		}
+
+	case "#reg":
+		sym := l.toks[i+1].s
+		if err := a.define(l, i+1, sym); err != nil {
+			return err
+		}
+		reg, err := a.reg(l, i+2)
+		if err != nil {
+			return err
+		}
+		a.regs[sym] = reg
+
+	case "#const":
+		sym := l.toks[i+1].s
+		if err := a.define(l, i+1, sym); err != nil {
+			return err
+		}
+		v, err := a.imm(l, i+2)
+		if err != nil {
+			return err
+		}
+		a.imms[sym] = v
+	}
+
+	return nil
+}
+
+// reg resolves the register operand at token i of l.
+func (a *assembler) reg(l *sourceLine, i int) (int, error) {
+	s := l.toks[i].s
+	v, ok := a.regs[s]
+	if !ok {
+		var err error
+		v, err = strconv.Atoi(s)
+		if err != nil {
+			return 0, l.errAt(i, "unknown register %q", s)
+		}
+	}
+	if v < 0 || v >= a.p.Arch.NReg {
+		return 0, l.errAt(i, "register %d out of range", v)
+	}
+	return v, nil
+}
+
+// imm resolves the immediate operand at token i of l.
+func (a *assembler) imm(l *sourceLine, i int) (int, error) {
+	s := l.toks[i].s
+	if v, ok := a.imms[s]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		if isIdent(s) {
+			return 0, l.errAt(i, "undefined %q", s)
+		}
+		return 0, l.errAt(i, "invalid number %q", s)
+	}
+	return v, nil
+}
+
+// emit adds the instructions of st to the program.
+func (a *assembler) emit(st asmStmt) error {
+	l, i := st.l, st.op
+	name := l.toks[i].s
+	args := l.toks[i+1:]
+
+	comment := l.comment
+	if comment == "" && pseudoSize[name] != 0 {
+		var v []string
+		for _, t := range l.toks[i:] {
+			v = append(v, t.s)
+		}
+		comment = strings.Join(v, " ")
+	}
+
+	nargs := instArgs
+	if op := a.set.Op(name); op != nil && len(args) != instArgs {
+		nargs = operands(op) // unused operands may be omitted
+	}
+	switch name {
+	case "jmp", "call":
+		nargs = 1
+	case "jz":
+		nargs = 2
+	case "ret":
+		nargs = 0
+	}
+
+	if len(args) < nargs {
+		return l.errAt(len(l.toks), "%s needs %d operands", name, nargs)
+	} else if len(args) > nargs {
+		return l.errAt(i+1+nargs, "unexpected %q", args[nargs].s)
+	}
+
+	if pseudoSize[name] != 0 && !a.p.Arch.IP.IsRegister {
+		return l.errAt(i, "%s needs the #ip directive", name)
+	}
+	ip := a.p.Arch.IP.Index
+
+	add := func(opname string, x, y, z int) {
+		a.p.add(l, Instruction{Op: a.set.Op(opname), A: x, B: y, C: z}, comment)
+		comment = ""
+	}
+
+	// the instruction pointer is incremented
+	// after each instruction, so jumps to
+	// address n set it to n-1
+	switch name {
+	case "jmp":
+		dst, err := a.imm(l, i+1)
+		if err != nil {
+			return err
+		}
+		add("seti", dst-1, 0, ip)
+
+	case "jz":
+		if a.scratch < 0 {
+			return l.errAt(i, "jz needs the #scratch directive")
+		}
+		reg, err := a.reg(l, i+1)
+		if err != nil {
+			return err
+		}
+		dst, err := a.imm(l, i+2)
+		if err != nil {
+			return err
+		}
+		add("eqri", reg, 0, a.scratch)
+		add("addr", a.scratch, ip, ip) // skip next if zero
+		add("addi", ip, 1, ip)         // skip jump
+		add("seti", dst-1, 0, ip)
+
+	case "call":
+		if a.link < 0 {
+			return l.errAt(i, "call needs the #link directive")
+		}
+		dst, err := a.imm(l, i+1)
+		if err != nil {
+			return err
+		}
+		add("seti", st.addr+1, 0, a.link) // return after the jump
+		add("seti", dst-1, 0, ip)
+
+	case "ret":
+		if a.link < 0 {
+			return l.errAt(i, "ret needs the #link directive")
+		}
+		add("setr", a.link, 0, ip)
+
+	default:
+		op := a.set.Op(name)
+		var v [instArgs]int
+		kinds := argKinds(op)
+		for j, k := range kinds[:nargs] {
+			var err error
+			if k == ArgReg {
+				v[j], err = a.reg(l, i+1+j)
+			} else {
+				v[j], err = a.imm(l, i+1+j)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		add(name, v[0], v[1], v[2])
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package wristdev
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// sumSource sums the numbers from n down to 1,
+// and doubles the result in a subroutine.
+const sumSource = `
+#ip 5
+#reg n 1
+#reg sum 0
+#scratch 2
+#link 3
+#const N 10
+
+	seti N 0 n
+loop:
+	jz n done
+	addr sum n sum
+	addi n -1 n
+	jmp loop
+done:
+	call double
+	jmp exit
+double: addr sum sum sum ; sum *= 2
+	ret
+exit:
+`
+
+func TestAssemble(t *testing.T) {
+	p, err := Assemble(strings.NewReader(sumSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := p.Arch.State()
+	if s.RunProgram(p.Inst, 1e4) {
+		t.Fatalf("program did not halt: %v", s)
+	}
+	if s.R[0] != 110 {
+		t.Errorf("got sum %d; want 110", s.R[0])
+	}
+
+	// assembled program must survive a round trip through standard source
+	var buf bytes.Buffer
+	if err := p.WriteSource(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	q, err := ParseSource(&buf)
+	if err != nil {
+		t.Fatalf("%v in:\n%s", err, buf.String())
+	}
+
+	if len(q.Inst) != len(p.Inst) {
+		t.Fatalf("got %d instructions after round trip; want %d", len(q.Inst), len(p.Inst))
+	}
+	for i := range p.Inst {
+		if p.Inst[i].String() != q.Inst[i].String() || p.Comments[i] != q.Comments[i] {
+			t.Errorf("%d: got %v ; %s after round trip; want %v ; %s",
+				i, q.Inst[i], q.Comments[i], p.Inst[i], p.Comments[i])
+		}
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	tests := []struct {
+		src       string
+		line, col int
+		token     string
+	}{
+		{"jmp x", 1, 1, "jmp"},
+		{"#ip 5\njmp x", 2, 5, "x"},
+		{"#ip 5\njz r1 l\nl:", 2, 1, "jz"},
+		{"#ip 5\n#link 4\ncall", 3, 5, ""},
+		{"addr r1 q r2", 1, 9, "q"},
+		{"a:\na: seti 1 0 0", 2, 1, "a:"},
+		{"#reg r1 2", 1, 6, "r1"},
+		{"#const c 1x", 1, 10, "1x"},
+	}
+
+	for _, tt := range tests {
+		_, err := Assemble(strings.NewReader(tt.src))
+		se, ok := err.(*SyntaxError)
+		if !ok {
+			t.Errorf("%q: got error %v; want syntax error", tt.src, err)
+			continue
+		}
+		if se.Line != tt.line || se.Col != tt.col || se.Token != tt.token {
+			t.Errorf("%q: got error %v at %d:%d %q; want %d:%d %q", tt.src, se,
+				se.Line, se.Col, se.Token, tt.line, tt.col, tt.token)
+		}
+	}
+}
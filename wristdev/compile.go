@@ -0,0 +1,398 @@
+package wristdev
+
+// Compiled is a program decoded for fast execution.
+//
+// Instructions of the built-in operators are run directly by
+// a switch loop on the registers of the state, without dispatch
+// through the Operator interface and IP indirection.
+// Comparisons followed by a branch on their result
+// are executed as a single step.
+// Other operators fall back to Operator.Run,
+// and may halt the device through State.Halted.
+//
+// Plain loops, such as that of BenchmarkCompiled, run about three
+// times as fast as with State.RunProgram. The switch loop is bound
+// by dispatch, threading closures instead measured hardly faster.
+//
+// Loops matching known idioms are replaced by
+// native computations, see Idioms.
+type Compiled struct {
+	// Verify enables checking the result of each idiom against
+	// executing the original instructions of its loop.
+	// A mismatch causes a panic with an *IdiomError.
+	Verify bool
+
+	arch *Architecture
+	prog []Instruction
+
+	code []compiledInst
+
+	idioms []*idiom  // idioms by start address
+	plain  *Compiled // program without idioms for Verify
+}
+
+type opcode uint8
+
+const (
+	opcGeneric opcode = iota // Operator.Run
+	opcAddr
+	opcAddi
+	opcMulr
+	opcMuli
+	opcBanr
+	opcBani
+	opcBorr
+	opcBori
+	opcSetr
+	opcSeti
+	opcGtir
+	opcGtri
+	opcGtrr
+	opcEqir
+	opcEqri
+	opcEqrr
+
+	opcNop   // write of an invalid register
+	opcIdiom // start of an idiom
+)
+
+var opcodes = map[string]opcode{
+	"addr": opcAddr,
+	"addi": opcAddi,
+	"mulr": opcMulr,
+	"muli": opcMuli,
+	"banr": opcBanr,
+	"bani": opcBani,
+	"borr": opcBorr,
+	"bori": opcBori,
+	"setr": opcSetr,
+	"seti": opcSeti,
+	"gtir": opcGtir,
+	"gtri": opcGtri,
+	"gtrr": opcGtrr,
+	"eqir": opcEqir,
+	"eqri": opcEqri,
+	"eqrr": opcEqrr,
+}
+
+// compiledInst is a decoded instruction.
+// Register operands are valid registers, reads of the
+// instruction pointer and invalid registers are folded
+// to immediates.
+type compiledInst struct {
+	op      opcode
+	a, b, c int
+
+	flow flow
+}
+
+// flow is the control flow after a compiled instruction.
+type flow uint8
+
+const (
+	flowNext flow = iota // write register c, continue with next instruction
+
+	flowJump // write the instruction pointer
+
+	// flowBranch is a comparison writing register c followed
+	// by an instruction adding c to the instruction pointer,
+	// that is executed together with the comparison.
+	flowBranch
+)
+
+// Compile decodes prog for running on arch.
+//
+// The instruction pointer register of instruction i always holds i
+// when the instruction is executed, therefore reads of it are
+// replaced by immediates, and writes of it are compiled to jumps.
+// This keeps the instruction pointer out of the register file.
+func Compile(arch *Architecture, prog []Instruction) *Compiled {
+	c := &Compiled{
+		arch: arch,
+		prog: prog,
+		code: make([]compiledInst, len(prog)),
+	}
+
+	for i, inst := range prog {
+		c.code[i] = c.compile(i, inst)
+	}
+
+	for i := 0; i+1 < len(prog); i++ {
+		if c.isBranch(i) {
+			c.code[i].flow = flowBranch
+		}
+	}
+
+	c.findIdioms()
+
+	return c
+}
+
+func (c *Compiled) compile(i int, inst Instruction) compiledInst {
+	ci := compiledInst{a: inst.A, b: inst.B, c: inst.C}
+	switch inst.Op.(type) {
+	case opSimple, opImmediate, opReg:
+		ci.op = opcodes[inst.Op.Name()]
+	}
+	if ci.op == opcGeneric {
+		return ci
+	}
+
+	n := c.arch.NReg
+	ip := -1
+	if c.arch.IP.IsRegister {
+		ip = c.arch.IP.Index
+	}
+
+	// reads of the instruction pointer register
+	// and invalid registers are constant
+	constReg := func(k ArgKind, r int) (v int, ok bool) {
+		switch {
+		case k != ArgReg:
+			return 0, false
+		case ip >= 0 && r == ip:
+			return i, true
+		case r < 0 || r >= n:
+			return 0, true
+		}
+		return 0, false
+	}
+	ak, bk := inst.Op.Args()
+	av, aconst := constReg(ak, ci.a)
+	bv, bconst := constReg(bk, ci.b)
+	if aconst || bconst {
+		ci = c.fold(i, inst, aconst, bconst, av, bv)
+	}
+
+	switch {
+	case ip >= 0 && ci.c == ip:
+		ci.flow = flowJump
+	case ci.c < 0 || ci.c >= n:
+		ci.op = opcNop
+	}
+	return ci
+}
+
+// fold replaces constant register reads of instruction i
+// with the immediates av and bv.
+func (c *Compiled) fold(i int, inst Instruction, aconst, bconst bool, av, bv int) compiledInst {
+	ci := compiledInst{a: inst.A, b: inst.B, c: inst.C}
+
+	ak, bk := inst.Op.Args()
+	if (aconst || ak != ArgReg) && (bconst || bk != ArgReg) {
+		// constant result, written to an extra register
+		// so that invalid registers still read zero
+		n := c.arch.NReg
+		s := Arch(n + 1).State()
+		if c.arch.IP.IsRegister {
+			s.R[c.arch.IP.Index] = i
+		}
+		inst.Op.Run(s, inst.A, inst.B, n)
+		ci.op, ci.a = opcSeti, s.R[n]
+		return ci
+	}
+
+	// one register operand remains
+	name := inst.Op.Name()
+	switch inst.Op.Prefix() {
+	case "add", "mul", "ban", "bor":
+		// commutative
+		if aconst {
+			ci.a, ci.b = inst.B, av
+		} else {
+			ci.b = bv
+		}
+		name = inst.Op.Prefix() + "i"
+	case "gt", "eq":
+		if aconst {
+			ci.a = av
+			name = inst.Op.Prefix() + "ir"
+		} else {
+			ci.b = bv
+			name = inst.Op.Prefix() + "ri"
+		}
+	}
+	ci.op = opcodes[name]
+	return ci
+}
+
+// isBranch reports if instruction i is a comparison
+// followed by a branch on its result.
+func (c *Compiled) isBranch(i int) bool {
+	switch c.code[i].op {
+	case opcGtir, opcGtri, opcGtrr, opcEqir, opcEqri, opcEqrr:
+	default:
+		return false
+	}
+	t := c.code[i].c
+	if c.code[i].flow != flowNext || t >= c.arch.NReg {
+		return false
+	}
+
+	// the branch reads the instruction pointer as i+1,
+	// and is compiled to t+(i+1) stored as a jump
+	br := c.code[i+1]
+	return br.op == opcAddi && br.flow == flowJump && br.a == t && br.b == i+1
+}
+
+// args reports the argument kinds of built-in opcodes.
+func (op opcode) args() (a, b ArgKind) {
+	switch op {
+	case opcAddr, opcMulr, opcBanr, opcBorr, opcGtrr, opcEqrr:
+		return ArgReg, ArgReg
+	case opcAddi, opcMuli, opcBani, opcBori, opcGtri, opcEqri:
+		return ArgReg, ArgImmediate
+	case opcGtir, opcEqir:
+		return ArgImmediate, ArgReg
+	case opcSetr:
+		return ArgReg, ArgUnused
+	}
+	return ArgImmediate, ArgUnused
+}
+
+// Run runs the program on s like s.RunProgram,
+// and reports if the process is still running.
+//
+// The architecture of s must be that of the compiled program.
+func (c *Compiled) Run(s *State, maxstep int) bool {
+	return c.RunUntil(s, maxstep, -1)
+}
+
+// RunUntil is like Run, but stops also when the
+// instruction pointer becomes addr after an instruction.
+func (c *Compiled) RunUntil(s *State, maxstep int, addr int) bool {
+	if s.Arch.NReg != c.arch.NReg || s.Arch.IP != c.arch.IP {
+		panic("architecture mismatch")
+	}
+
+	if s.Halted {
+		return false
+	}
+	if s.Tracer != nil {
+		return c.runTraced(s, maxstep, addr)
+	}
+
+	ip, steps := c.exec(s, s.R, *s.IP, maxstep, addr)
+
+	*s.IP = ip
+	s.Steps += steps
+
+	return s.Running(c.prog)
+}
+
+// runTraced runs the original instructions
+// so that s.Tracer sees each of them.
+func (c *Compiled) runTraced(s *State, maxstep int, addr int) bool {
+	for i := 0; i < maxstep && s.Step(c.prog); i++ {
+		if *s.IP == addr {
+			break
+		}
+	}
+	return s.Running(c.prog)
+}
+
+// exec runs the program from ip on the register file r,
+// and returns the new instruction pointer and the number of steps.
+// State s is used by instructions of other operators.
+func (c *Compiled) exec(s *State, r []int, ip, maxstep, addr int) (int, int) {
+	n := c.arch.NReg
+	code := c.code
+	i := maxstep
+loop:
+	for ; i > 0 && uint(ip) < uint(len(code)); i-- {
+		in := &code[ip]
+
+		var v int
+	dispatch:
+		switch in.op {
+		case opcAddr:
+			v = r[in.a] + r[in.b]
+		case opcAddi:
+			v = r[in.a] + in.b
+		case opcMulr:
+			v = r[in.a] * r[in.b]
+		case opcMuli:
+			v = r[in.a] * in.b
+		case opcBanr:
+			v = r[in.a] & r[in.b]
+		case opcBani:
+			v = r[in.a] & in.b
+		case opcBorr:
+			v = r[in.a] | r[in.b]
+		case opcBori:
+			v = r[in.a] | in.b
+		case opcSetr:
+			v = r[in.a]
+		case opcSeti:
+			v = in.a
+		case opcGtir:
+			v = b2i(in.a > r[in.b])
+		case opcGtri:
+			v = b2i(r[in.a] > in.b)
+		case opcGtrr:
+			v = b2i(r[in.a] > r[in.b])
+		case opcEqir:
+			v = b2i(in.a == r[in.b])
+		case opcEqri:
+			v = b2i(r[in.a] == in.b)
+		case opcEqrr:
+			v = b2i(r[in.a] == r[in.b])
+		case opcNop:
+			ip++
+			goto next
+		case opcIdiom:
+			id := c.idioms[ip]
+			if nip, steps, ok := c.runIdiom(s, id, r, i, addr); ok {
+				ip = nip
+				i -= steps - 1
+				goto next
+			}
+			in = &id.inst
+			goto dispatch
+		default:
+			copy(s.R, r[:n])
+			*s.IP = ip
+			inst := c.prog[ip]
+			inst.Op.Run(s, inst.A, inst.B, inst.C)
+			copy(r, s.R)
+			ip = *s.IP + 1
+			if s.Halted {
+				i--
+				break loop
+			}
+			goto next
+		}
+
+		switch in.flow {
+		case flowNext:
+			r[in.c] = v
+			ip++
+		case flowJump:
+			ip = v + 1
+		case flowBranch:
+			r[in.c] = v
+			if i < 2 || ip+1 == addr {
+				// stop between the instructions
+				ip++
+			} else {
+				ip += 2 + v
+				i--
+			}
+		}
+
+	next:
+		if ip == addr {
+			i--
+			break
+		}
+	}
+
+	return ip, maxstep - i
+}
+
+func b2i(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
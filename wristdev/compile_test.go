@@ -0,0 +1,154 @@
+package wristdev
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestCompiled(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	ops := append([]Operator{}, Ops()...)
+	ops = append(ops, testNegOp{})
+
+	for iter := 0; iter < 1000; iter++ {
+		const nreg = 4
+
+		var arch *Architecture
+		if iter%4 == 0 {
+			arch = Arch(nreg)
+		} else {
+			arch = ArchWithIP(nreg, rng.Intn(nreg))
+		}
+
+		// operands include invalid registers
+		prog := make([]Instruction, 1+rng.Intn(10))
+		for i := range prog {
+			prog[i] = Instruction{
+				Op: ops[rng.Intn(len(ops))],
+				A:  rng.Intn(nreg+2) - 1,
+				B:  rng.Intn(nreg+2) - 1,
+				C:  rng.Intn(nreg+2) - 1,
+			}
+		}
+
+		var values []int
+		for i := 0; i < nreg; i++ {
+			values = append(values, rng.Intn(5))
+		}
+
+		want := arch.State(values...)
+		got := want.Clone()
+
+		const maxstep = 100
+		wantRunning := want.RunProgram(prog, maxstep)
+		gotRunning := Compile(arch, prog).Run(got, maxstep)
+
+		if gotRunning != wantRunning || !got.RegistersEqual(want) || *got.IP != *want.IP ||
+			got.Steps != want.Steps {
+			var sb strings.Builder
+			for _, inst := range prog {
+				sb.WriteString(inst.String() + "\n")
+			}
+			t.Fatalf("%v from %v:\n%sgot %v running=%v steps=%d; want %v running=%v steps=%d",
+				arch.IP, values, sb.String(), got, gotRunning, got.Steps, want, wantRunning, want.Steps)
+		}
+	}
+}
+
+func TestCompiledRunUntil(t *testing.T) {
+	p, err := ParseSource(strings.NewReader(countSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := p.Arch.State()
+	c := Compile(p.Arch, p.Inst)
+	for i := 1; i <= 3; i++ {
+		if !c.RunUntil(s, 1e6, 2) {
+			t.Fatal("program halted")
+		}
+		if *s.IP != 2 || s.R[0] != i {
+			t.Fatalf("got %v; want ip=2 r0=%d", s, i)
+		}
+	}
+}
+
+func TestCompiledBranch(t *testing.T) {
+	p, err := ParseSource(strings.NewReader(countSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// stop between the comparison and the branch
+	c := Compile(p.Arch, p.Inst)
+	for maxstep := 0; maxstep < 20; maxstep++ {
+		want := p.Arch.State(0, 0, 0, 0, 0, 0)
+		got := want.Clone()
+		want.RunProgram(p.Inst, maxstep)
+		c.Run(got, maxstep)
+		if !got.RegistersEqual(want) {
+			t.Errorf("%d steps: got %v; want %v", maxstep, got, want)
+		}
+	}
+}
+
+// testNegOp is an operator not known to Compile.
+type testNegOp struct{}
+
+func (testNegOp) Name() string         { return "negr" }
+func (testNegOp) Prefix() string       { return "neg" }
+func (testNegOp) Args() (a, b ArgKind) { return ArgReg, ArgUnused }
+func (testNegOp) Run(s *State, a, b, c int) {
+	if p := s.preg(c); p != nil {
+		*p = -s.reg(a)
+	}
+}
+
+// countSource counts r0 to 100000.
+const countSource = `#ip 5
+seti 0 0 0
+addi 0 1 0
+gtri 0 99999 1
+addr 1 5 5
+seti 0 0 5
+`
+
+func BenchmarkRunProgram(b *testing.B) {
+	benchCount(b, func(p *Program) func(s *State) {
+		return func(s *State) { s.RunProgram(p.Inst, 1e9) }
+	})
+}
+
+func BenchmarkCompiled(b *testing.B) {
+	benchCount(b, func(p *Program) func(s *State) {
+		c := Compile(p.Arch, p.Inst)
+		return func(s *State) { c.Run(s, 1e9) }
+	})
+}
+
+// benchCount benchmarks running countSource with the
+// function returned by prepare, and checks that it
+// ends in the same state as RunProgram.
+func benchCount(b *testing.B, prepare func(p *Program) func(s *State)) {
+	p, err := ParseSource(strings.NewReader(countSource))
+	if err != nil {
+		b.Fatal(err)
+	}
+	want := p.Arch.State()
+	want.RunProgram(p.Inst, 1e9)
+
+	run := prepare(p)
+	b.ResetTimer()
+	var s *State
+	for i := 0; i < b.N; i++ {
+		s = p.Arch.State()
+		run(s)
+	}
+	b.StopTimer()
+
+	if !s.RegistersEqual(want) || *s.IP != *want.IP || s.Steps != want.Steps {
+		b.Fatalf("got %v after %d steps; want %v after %d steps", s, s.Steps, want, want.Steps)
+	}
+}
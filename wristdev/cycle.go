@@ -0,0 +1,43 @@
+package wristdev
+
+import (
+	"fmt"
+
+	"github.com/tajtiattila/aoc18/cycle"
+)
+
+// FindCycle runs the program from s, and returns the cycle of the
+// values of registers regs each time the instruction pointer becomes at.
+// The registers must determine the values at the next visit,
+// all registers are used if regs is nil.
+// It reports false if the program halts, at is not reached
+// within maxstep steps after the previous visit,
+// or no values repeat in limit visits.
+//
+// Visit 0 is when at is first reached. Visits holds the values
+// of regs at each visit before the first repeat,
+// Tail+Period of them, so that they need not be run again.
+func (c *Compiled) FindCycle(s *State, at int, regs []int, limit, maxstep int) (cyc cycle.Cycle, visits [][]int, ok bool) {
+	if regs == nil {
+		for i := range s.R {
+			regs = append(regs, i)
+		}
+	}
+
+	h := cycle.NewHistory[string]()
+	for i := 0; i < limit; i++ {
+		if !c.RunUntil(s, maxstep, at) || *s.IP != at {
+			break
+		}
+
+		v := make([]int, len(regs))
+		for j, r := range regs {
+			v[j] = s.Reg(r)
+		}
+		if cyc, ok := h.Add(fmt.Sprint(v)); ok {
+			return cyc, visits, true
+		}
+		visits = append(visits, v)
+	}
+	return cycle.Cycle{}, nil, false
+}
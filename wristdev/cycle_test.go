@@ -0,0 +1,63 @@
+package wristdev
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tajtiattila/aoc18/cycle"
+)
+
+// lcgSource computes r0 = (3*r0 + 1) & 15 forever,
+// while counting iterations in r1.
+const lcgSource = `#ip 5
+muli 0 3 0
+addi 0 1 0
+bani 0 15 0
+addi 1 1 1
+seti -1 0 5
+`
+
+func TestFindCycle(t *testing.T) {
+	p, err := ParseSource(strings.NewReader(lcgSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := Compile(p.Arch, p.Inst)
+
+	f := func(x int) int { return (3*x + 1) & 15 }
+	for x0 := 0; x0 < 16; x0++ {
+		want := cycle.Brent(f(x0), f)
+		got, visits, ok := code.FindCycle(p.Arch.State(x0), 0, []int{0}, 100, 100)
+		if !ok || got != want {
+			t.Errorf("r0=%d: got %+v %v; want %+v", x0, got, ok, want)
+		}
+		if len(visits) != got.Tail+got.Period {
+			t.Fatalf("r0=%d: got %d visits", x0, len(visits))
+		}
+		for i, x := 0, f(x0); i < len(visits); i, x = i+1, f(x) {
+			if visits[i][0] != x {
+				t.Errorf("r0=%d: got %d at visit %d; want %d", x0, visits[i][0], i, x)
+			}
+		}
+	}
+
+	// the counter never repeats
+	if _, _, ok := code.FindCycle(p.Arch.State(), 0, nil, 100, 100); ok {
+		t.Error("cycle found with counter")
+	}
+
+	// at is never reached
+	if _, _, ok := code.FindCycle(p.Arch.State(), 7, nil, 100, 1000); ok {
+		t.Error("cycle found without visits")
+	}
+
+	// halting program
+	src := strings.Replace(countSource, "99999", "9", 1)
+	p, err = ParseSource(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := Compile(p.Arch, p.Inst).FindCycle(p.Arch.State(), 2, nil, 100, 1e6); ok {
+		t.Error("cycle found in halting program")
+	}
+}
@@ -0,0 +1,388 @@
+package wristdev
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Condition is a comparison of a register with a value.
+type Condition struct {
+	Reg   int
+	Op    string // one of == != < <= > >=
+	Value int
+}
+
+var conditionRe = regexp.MustCompile(`^\s*r(\d+)\s*(==|!=|<=|>=|<|>)\s*(-?\d+)\s*$`)
+
+// ParseCondition parses a condition such as "r3 > 10".
+func ParseCondition(s string) (Condition, error) {
+	m := conditionRe.FindStringSubmatch(s)
+	if m == nil {
+		return Condition{}, errors.Errorf("invalid condition %q", s)
+	}
+	reg, _ := strconv.Atoi(m[1])
+	v, err := strconv.Atoi(m[3])
+	if err != nil {
+		return Condition{}, errors.Wrapf(err, "invalid condition %q", s)
+	}
+	return Condition{Reg: reg, Op: m[2], Value: v}, nil
+}
+
+func (c Condition) String() string {
+	return fmt.Sprintf("r%d %s %d", c.Reg, c.Op, c.Value)
+}
+
+// Eval reports if c holds in s.
+func (c Condition) Eval(s *State) bool {
+	return c.holds(s.reg(c.Reg))
+}
+
+// holds reports if c holds with v in register c.Reg.
+func (c Condition) holds(v int) bool {
+	switch c.Op {
+	case "==":
+		return v == c.Value
+	case "!=":
+		return v != c.Value
+	case "<":
+		return v < c.Value
+	case "<=":
+		return v <= c.Value
+	case ">":
+		return v > c.Value
+	case ">=":
+		return v >= c.Value
+	}
+	panic("invalid condition operator")
+}
+
+// Breakpoint stops execution before an instruction.
+type Breakpoint struct {
+	Addr int
+
+	// Cond makes the breakpoint conditional if not nil.
+	Cond *Condition
+
+	Hits int // number of times execution stopped
+}
+
+// Watchpoint stops execution after an instruction
+// writes a register, or changes its value to satisfy a condition.
+type Watchpoint struct {
+	Reg int
+
+	// Cond, if not nil, makes the watchpoint trigger when it
+	// becomes true, instead of on writes to Reg.
+	Cond *Condition
+
+	Hits int // number of times execution stopped
+}
+
+// StopReason is the reason the debugger stopped execution.
+type StopReason int
+
+const (
+	StopStep  StopReason = iota // requested steps executed
+	StopBreak                   // breakpoint hit
+	StopWatch                   // watchpoint triggered
+	StopHalt                    // program halted
+)
+
+func (r StopReason) String() string {
+	switch r {
+	case StopStep:
+		return "step"
+	case StopBreak:
+		return "breakpoint"
+	case StopWatch:
+		return "watchpoint"
+	case StopHalt:
+		return "halt"
+	}
+	return fmt.Sprintf("StopReason(%d)", int(r))
+}
+
+// Event describes why execution stopped.
+type Event struct {
+	Reason StopReason
+
+	Break *Breakpoint // for StopBreak
+	Watch *Watchpoint // for StopWatch
+
+	// Old and New are the values of the watched register for StopWatch.
+	Old, New int
+}
+
+// Debugger runs a program under control.
+type Debugger struct {
+	Prog  []Instruction
+	State *State
+
+	Steps int // number of instructions executed
+
+	// Hits holds the number of times each instruction was executed.
+	Hits []int
+
+	breaks  map[int]*Breakpoint
+	watches []*Watchpoint
+
+	prev State // registers before the last step
+	hist history
+
+	// atBreak is set when execution stopped at the breakpoint
+	// of the current instruction, so that resuming runs it.
+	atBreak bool
+}
+
+// NewDebugger returns a debugger running prog from s.
+// Up to history steps can be reversed.
+func NewDebugger(s *State, prog []Instruction, history int) *Debugger {
+	d := &Debugger{
+		Prog:   prog,
+		State:  s,
+		Hits:   make([]int, len(prog)),
+		breaks: make(map[int]*Breakpoint),
+	}
+	d.hist.buf = make([]snapshot, history)
+	return d
+}
+
+// Halted reports if the device halted, or the
+// instruction pointer is outside of the program.
+func (d *Debugger) Halted() bool {
+	return !d.State.Running(d.Prog)
+}
+
+// Break sets a breakpoint at addr, replacing the one already there.
+func (d *Debugger) Break(addr int, cond *Condition) *Breakpoint {
+	bp := &Breakpoint{Addr: addr, Cond: cond}
+	d.breaks[addr] = bp
+	return bp
+}
+
+// ClearBreak removes the breakpoint at addr,
+// and reports if there was one.
+func (d *Debugger) ClearBreak(addr int) bool {
+	_, ok := d.breaks[addr]
+	delete(d.breaks, addr)
+	return ok
+}
+
+// Breakpoints returns the breakpoints ordered by address.
+func (d *Debugger) Breakpoints() []*Breakpoint {
+	var v []*Breakpoint
+	for addr := range d.Prog {
+		if bp, ok := d.breaks[addr]; ok {
+			v = append(v, bp)
+		}
+	}
+	return v
+}
+
+// WatchWrites sets a watchpoint on writes to register reg.
+func (d *Debugger) WatchWrites(reg int) *Watchpoint {
+	wp := &Watchpoint{Reg: reg}
+	d.watches = append(d.watches, wp)
+	return wp
+}
+
+// WatchValue sets a watchpoint triggered when c becomes true.
+func (d *Debugger) WatchValue(c Condition) *Watchpoint {
+	wp := &Watchpoint{Reg: c.Reg, Cond: &c}
+	d.watches = append(d.watches, wp)
+	return wp
+}
+
+// ClearWatch removes the watchpoints on register reg,
+// and returns the number of watchpoints removed.
+func (d *Debugger) ClearWatch(reg int) int {
+	v := d.watches[:0]
+	for _, wp := range d.watches {
+		if wp.Reg != reg {
+			v = append(v, wp)
+		}
+	}
+	n := len(d.watches) - len(v)
+	d.watches = v
+	return n
+}
+
+// Watchpoints returns the watchpoints.
+func (d *Debugger) Watchpoints() []*Watchpoint {
+	return d.watches
+}
+
+// Step executes up to n instructions.
+// It stops early on watchpoints or when the program halts,
+// but not on breakpoints.
+func (d *Debugger) Step(n int) Event {
+	return d.run(n, -1, false)
+}
+
+// Next executes instructions until the one after the current
+// instruction is reached, stepping over loops closed by a jump
+// at the current instruction.
+// It stops early like Continue.
+func (d *Debugger) Next(maxstep int) Event {
+	if d.Halted() {
+		return Event{Reason: StopHalt}
+	}
+	return d.run(maxstep, *d.State.IP+1, true)
+}
+
+// Continue executes up to maxstep instructions, stopping before
+// the instruction of a breakpoint, after a triggered watchpoint,
+// or when the program halts.
+func (d *Debugger) Continue(maxstep int) Event {
+	return d.run(maxstep, -1, true)
+}
+
+// run executes up to maxstep instructions, or until
+// the instruction pointer becomes until.
+func (d *Debugger) run(maxstep, until int, breaks bool) Event {
+	if d.Halted() {
+		return Event{Reason: StopHalt}
+	}
+
+	if breaks && !d.atBreak {
+		if ev, stop := d.checkBreak(); stop {
+			return ev
+		}
+	}
+
+	for i := 0; i < maxstep; i++ {
+		if d.Halted() {
+			return Event{Reason: StopHalt}
+		}
+
+		if ev, stop := d.step(); stop {
+			return ev
+		}
+
+		if *d.State.IP == until {
+			break
+		}
+
+		if breaks {
+			if ev, stop := d.checkBreak(); stop {
+				return ev
+			}
+		}
+	}
+
+	if d.Halted() {
+		return Event{Reason: StopHalt}
+	}
+	return Event{Reason: StopStep}
+}
+
+// checkBreak reports a breakpoint hit at the current instruction.
+func (d *Debugger) checkBreak() (Event, bool) {
+	bp := d.breaks[*d.State.IP]
+	if bp == nil || (bp.Cond != nil && !bp.Cond.Eval(d.State)) {
+		return Event{}, false
+	}
+	bp.Hits++
+	d.atBreak = true
+	return Event{Reason: StopBreak, Break: bp}, true
+}
+
+// step executes the next instruction, and reports a triggered watchpoint.
+func (d *Debugger) step() (Event, bool) {
+	s := d.State
+	ip := *s.IP
+	inst := d.Prog[ip]
+
+	d.atBreak = false
+	d.hist.push(s)
+	d.prev.R = append(d.prev.R[:0], s.R...)
+
+	s.Run(inst.Op, inst.A, inst.B, inst.C)
+	d.Steps++
+	d.Hits[ip]++
+
+	for _, wp := range d.watches {
+		was, now := d.prev.reg(wp.Reg), s.reg(wp.Reg)
+		var hit bool
+		if wp.Cond == nil {
+			hit = Writes(inst.Op) && inst.C == wp.Reg
+		} else {
+			hit = !wp.Cond.holds(was) && wp.Cond.holds(now)
+		}
+		if hit {
+			wp.Hits++
+			return Event{Reason: StopWatch, Watch: wp, Old: was, New: now}, true
+		}
+	}
+
+	return Event{}, false
+}
+
+// Back reverses the last step, and reports if it was possible.
+func (d *Debugger) Back() bool {
+	snap, ok := d.hist.pop()
+	if !ok {
+		return false
+	}
+
+	d.atBreak = false
+	s := d.State
+	copy(s.R, snap.r)
+	*s.IP = snap.ip
+	s.Halted = false
+	s.Output = s.Output[:snap.nout]
+	s.Steps--
+	d.Steps--
+	d.Hits[snap.ip]--
+	return true
+}
+
+// History returns the number of steps that can be reversed.
+func (d *Debugger) History() int {
+	return d.hist.n
+}
+
+// snapshot is the state before a step.
+type snapshot struct {
+	r  []int
+	ip int
+
+	nout int // length of the output
+}
+
+// history is a ring buffer of snapshots.
+type history struct {
+	buf []snapshot
+
+	n    int // number of snapshots
+	next int // index of the next snapshot
+}
+
+// push records s.
+func (h *history) push(s *State) {
+	if len(h.buf) == 0 {
+		return
+	}
+
+	p := &h.buf[h.next]
+	p.r = append(p.r[:0], s.R...)
+	p.ip = *s.IP
+	p.nout = len(s.Output)
+
+	h.next = (h.next + 1) % len(h.buf)
+	if h.n < len(h.buf) {
+		h.n++
+	}
+}
+
+func (h *history) pop() (snapshot, bool) {
+	if h.n == 0 {
+		return snapshot{}, false
+	}
+	h.n--
+	h.next = (h.next + len(h.buf) - 1) % len(h.buf)
+	return h.buf[h.next], true
+}
@@ -0,0 +1,175 @@
+package wristdev
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTestDebugger(t *testing.T, src string, history int) *Debugger {
+	p, err := ParseSource(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewDebugger(p.Arch.State(), p.Inst, history)
+}
+
+func TestParseCondition(t *testing.T) {
+	tests := []struct {
+		src  string
+		want Condition
+	}{
+		{"r3 > 10", Condition{Reg: 3, Op: ">", Value: 10}},
+		{"r0==-1", Condition{Reg: 0, Op: "==", Value: -1}},
+		{" r5 <= 7 ", Condition{Reg: 5, Op: "<=", Value: 7}},
+	}
+	for _, tt := range tests {
+		got, err := ParseCondition(tt.src)
+		if err != nil {
+			t.Errorf("%q: %v", tt.src, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: got %v; want %v", tt.src, got, tt.want)
+		}
+	}
+
+	for _, src := range []string{"", "r3", "x > 1", "r1 = 2", "r1 > a"} {
+		if _, err := ParseCondition(src); err == nil {
+			t.Errorf("%q: no error", src)
+		}
+	}
+}
+
+func TestDebuggerBreak(t *testing.T) {
+	d := newTestDebugger(t, countSource, 0)
+
+	bp := d.Break(2, nil)
+	for i := 1; i <= 3; i++ {
+		ev := d.Continue(1e6)
+		if ev.Reason != StopBreak || ev.Break != bp {
+			t.Fatalf("got %v; want breakpoint", ev.Reason)
+		}
+		if *d.State.IP != 2 || d.State.R[0] != i {
+			t.Fatalf("got %v; want ip=2 r0=%d", d.State, i)
+		}
+	}
+
+	// conditional
+	d.Break(2, &Condition{Reg: 0, Op: "==", Value: 100})
+	if ev := d.Continue(1e6); ev.Reason != StopBreak || d.State.R[0] != 100 {
+		t.Fatalf("got %v at %v; want breakpoint at r0=100", ev.Reason, d.State)
+	}
+
+	d.ClearBreak(2)
+	if ev := d.Continue(1e6); ev.Reason != StopHalt {
+		t.Fatalf("got %v; want halt", ev.Reason)
+	}
+	if d.State.R[0] != 100000 {
+		t.Errorf("got %v at halt", d.State)
+	}
+	if d.Hits[1] != 100000 || d.Hits[0] != 1 {
+		t.Errorf("got hits %v", d.Hits)
+	}
+}
+
+func TestDebuggerBreakEntry(t *testing.T) {
+	d := newTestDebugger(t, countSource, 0)
+
+	bp := d.Break(0, nil)
+	if ev := d.Continue(1e6); ev.Reason != StopBreak || ev.Break != bp || d.Steps != 0 {
+		t.Fatalf("got %v after %d steps; want breakpoint before the first", ev.Reason, d.Steps)
+	}
+
+	// resuming runs the instruction at the breakpoint
+	if ev := d.Continue(1e6); ev.Reason != StopHalt {
+		t.Fatalf("got %v; want halt", ev.Reason)
+	}
+	if bp.Hits != 1 || d.Hits[0] != 1 {
+		t.Errorf("got %d breakpoint hits and %d executions; want 1", bp.Hits, d.Hits[0])
+	}
+}
+
+func TestDebuggerWatch(t *testing.T) {
+	d := newTestDebugger(t, countSource, 0)
+
+	d.WatchWrites(1)
+	ev := d.Continue(1e6)
+	if ev.Reason != StopWatch || *d.State.IP != 3 {
+		t.Fatalf("got %v at %v; want watchpoint after instruction 2", ev.Reason, d.State)
+	}
+
+	d.ClearWatch(1)
+	d.WatchValue(Condition{Reg: 0, Op: ">=", Value: 50})
+	ev = d.Continue(1e6)
+	if ev.Reason != StopWatch || ev.Old != 49 || ev.New != 50 {
+		t.Fatalf("got %+v; want watchpoint from 49 to 50", ev)
+	}
+
+	// triggers only when the condition becomes true
+	d.Break(4, nil)
+	if ev := d.Continue(1e6); ev.Reason != StopBreak {
+		t.Fatalf("got %v; want breakpoint", ev.Reason)
+	}
+}
+
+func TestDebuggerNext(t *testing.T) {
+	d := newTestDebugger(t, countSource, 0)
+
+	// step to the jump closing the loop
+	d.Step(4)
+	if *d.State.IP != 4 {
+		t.Fatalf("got %v; want ip=4", d.State)
+	}
+
+	if ev := d.Next(1e6); ev.Reason != StopHalt || d.State.R[0] != 100000 {
+		t.Fatalf("got %v at %v; want halt", ev.Reason, d.State)
+	}
+}
+
+func TestDebuggerBack(t *testing.T) {
+	d := newTestDebugger(t, countSource, 10)
+
+	var states []string
+	for i := 0; i < 20; i++ {
+		states = append(states, d.State.String())
+		d.Step(1)
+	}
+
+	for i := 19; i >= 10; i-- {
+		if !d.Back() {
+			t.Fatalf("can't reverse step %d", i)
+		}
+		if got := d.State.String(); got != states[i] {
+			t.Fatalf("step %d: got %s; want %s", i, got, states[i])
+		}
+	}
+
+	if d.Back() {
+		t.Fatal("reversed beyond history")
+	}
+	if d.Steps != 10 {
+		t.Errorf("got %d steps; want 10", d.Steps)
+	}
+}
+
+func TestDebuggerWatchVoid(t *testing.T) {
+	// out and halt don't write register c, even if it is given
+	prog, err := Extended.ParseProgram([]string{
+		"out 0 0 1",
+		"seti 7 0 1",
+		"halt 0 0 1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDebugger(Arch(2).WithSet(Extended).State(3, 0), prog, 10)
+	d.WatchWrites(1)
+
+	ev := d.Continue(100)
+	if ev.Reason != StopWatch || d.Steps != 2 || ev.New != 7 {
+		t.Fatalf("got %v at step %d; want watch after seti", ev.Reason, d.Steps)
+	}
+	if ev := d.Continue(100); ev.Reason != StopHalt {
+		t.Errorf("got %v; want halt", ev.Reason)
+	}
+}
@@ -0,0 +1,195 @@
+package wristdev
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// StepFunc advances a reference implementation of a program
+// to its next checkpoint, and returns its registers there,
+// or nil if the reference ended.
+type StepFunc func() []int
+
+// DiffTest runs a program and reference implementations
+// in lockstep, comparing their registers at checkpoints.
+type DiffTest struct {
+	Prog []Instruction
+
+	// At is the address of the checkpoints: the registers are
+	// compared each time the instruction pointer of the program
+	// becomes At after an instruction.
+	// If At is negative, they are compared after every instruction.
+	At int
+
+	// Regs holds the registers compared in the order returned
+	// by the references. All registers are compared if Regs is nil.
+	Regs []int
+
+	// Checkpoints is the number of checkpoints compared.
+	// It must be positive.
+	Checkpoints int
+
+	// MaxStep is the maximum number of instructions run
+	// between checkpoints, or 0 for no limit.
+	MaxStep int
+}
+
+// Divergence is the first difference found by DiffTest.Run.
+type Divergence struct {
+	Checkpoint int // index of the checkpoint, starting at 0
+
+	Steps int   // instructions executed by the program
+	IP    int   // instruction pointer of the program
+	R     []int // all registers of the program
+
+	// Regs holds the registers compared.
+	Regs []int
+
+	// Prog holds the values of Regs in the program, and Refs
+	// those of each reference. They are nil if the program
+	// or the reference ended, or the program exceeded MaxStep.
+	Prog []int
+	Refs [][]int
+
+	Ref int // index of the first diverging reference
+}
+
+func (d *Divergence) Error() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "checkpoint %d (step %d, ip=%d): reference %d diverges", d.Checkpoint, d.Steps, d.IP, d.Ref)
+
+	ref := d.Refs[d.Ref]
+	switch {
+	case d.Prog == nil:
+		buf.WriteString(", program ended")
+	case ref == nil:
+		buf.WriteString(", reference ended")
+	default:
+		sep := " in"
+		for i, r := range d.Regs {
+			if i >= len(ref) || ref[i] != d.Prog[i] {
+				fmt.Fprintf(&buf, "%s r%d", sep, r)
+				sep = ","
+			}
+		}
+	}
+
+	fmt.Fprintf(&buf, "\n  registers    %v", d.Regs)
+	fmt.Fprintf(&buf, "\n  program      %v", valuesOrEnded(d.Prog))
+	for i, v := range d.Refs {
+		fmt.Fprintf(&buf, "\n  reference %-2d %v", i, valuesOrEnded(v))
+	}
+	fmt.Fprintf(&buf, "\n  program state ip=%d %v", d.IP, d.R)
+	return buf.String()
+}
+
+func valuesOrEnded(v []int) string {
+	if v == nil {
+		return "ended"
+	}
+	return fmt.Sprint(v)
+}
+
+// Run runs the program from s and refs until t.Checkpoints
+// checkpoints, or until the program and all references end.
+// The first difference is returned as a *Divergence.
+//
+// The program runs with idioms verified against its instructions,
+// and a mismatch is returned as an *IdiomError.
+func (t *DiffTest) Run(s *State, refs ...StepFunc) (err error) {
+	if t.Checkpoints <= 0 {
+		return errors.Errorf("invalid number of checkpoints %d", t.Checkpoints)
+	}
+
+	regs := t.Regs
+	if regs == nil {
+		for i := range s.R {
+			regs = append(regs, i)
+		}
+	}
+
+	maxstep := t.MaxStep
+	if maxstep <= 0 {
+		maxstep = int(^uint(0) >> 1)
+	}
+
+	var code *Compiled
+	if t.At >= 0 {
+		code = Compile(s.Arch, t.Prog)
+		code.Verify = true
+		defer func() {
+			switch x := recover().(type) {
+			case nil:
+			case *IdiomError:
+				err = x
+			default:
+				panic(x)
+			}
+		}()
+	}
+
+	for cp := 0; cp < t.Checkpoints; cp++ {
+		var ok bool
+		if code != nil {
+			code.RunUntil(s, maxstep, t.At)
+			ok = *s.IP == t.At && !s.Halted
+		} else {
+			ok = s.Running(t.Prog)
+			s.Step(t.Prog)
+		}
+
+		var prog []int
+		if ok {
+			prog = make([]int, len(regs))
+			for i, r := range regs {
+				prog[i] = s.Reg(r)
+			}
+		}
+
+		d := &Divergence{
+			Checkpoint: cp,
+			Steps:      s.Steps,
+			IP:         *s.IP,
+			R:          append([]int(nil), s.R...),
+			Regs:       regs,
+			Prog:       prog,
+			Refs:       make([][]int, len(refs)),
+			Ref:        -1,
+		}
+
+		ended := prog == nil
+		for i, ref := range refs {
+			v := ref()
+			d.Refs[i] = v
+			if d.Ref < 0 && !equalRegs(prog, v) {
+				d.Ref = i
+			}
+			ended = ended && v == nil
+		}
+
+		if d.Ref >= 0 {
+			return d
+		}
+		if ended {
+			break
+		}
+	}
+
+	return nil
+}
+
+// equalRegs reports if a and b are equal,
+// treating nil and empty slices as different.
+func equalRegs(a, b []int) bool {
+	if (a == nil) != (b == nil) || len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
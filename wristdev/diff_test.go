@@ -0,0 +1,135 @@
+package wristdev
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// countRef returns a reference of countSource
+// at address 2, that is wrong at bad.
+func countRef(limit, bad int) StepFunc {
+	r0 := 0
+	return func() []int {
+		if r0 == limit {
+			return nil
+		}
+		r0++
+		if r0 == bad {
+			return []int{r0 + 1}
+		}
+		return []int{r0}
+	}
+}
+
+func TestDiffTest(t *testing.T) {
+	src := strings.Replace(countSource, "99999", "9", 1)
+	p, err := ParseSource(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dt := DiffTest{
+		Prog:        p.Inst,
+		At:          2,
+		Regs:        []int{0},
+		Checkpoints: 100,
+	}
+
+	if err := dt.Run(p.Arch.State(), countRef(10, -1), countRef(10, -1)); err != nil {
+		t.Fatal(err)
+	}
+
+	err = dt.Run(p.Arch.State(), countRef(10, -1), countRef(10, 7))
+	d, ok := err.(*Divergence)
+	if !ok {
+		t.Fatalf("got %v; want divergence", err)
+	}
+	if d.Checkpoint != 6 || d.Ref != 1 || d.Prog[0] != 7 || d.Refs[1][0] != 8 || d.IP != 2 {
+		t.Errorf("got %+v", d)
+	}
+	if !reflect.DeepEqual(d.R, []int{7, 0, 0, 0, 0, 2}) {
+		t.Errorf("got registers %v", d.R)
+	}
+	if msg := d.Error(); !strings.Contains(msg, "reference 1 diverges in r0") ||
+		!strings.Contains(msg, "program state ip=2 [7 0 0 0 0 2]") {
+		t.Errorf("got message %q", msg)
+	}
+
+	// nothing to compare
+	zero := dt
+	zero.Checkpoints = 0
+	if err := zero.Run(p.Arch.State(), countRef(10, -1)); err == nil {
+		t.Error("no error without checkpoints")
+	}
+
+	// reference ends early
+	err = dt.Run(p.Arch.State(), countRef(5, -1))
+	if d, ok := err.(*Divergence); !ok || d.Checkpoint != 5 || d.Refs[0] != nil {
+		t.Errorf("got %v; want divergence after reference ended", err)
+	}
+
+	// every instruction
+	dt.At, dt.Regs = -1, nil
+	s := p.Arch.State()
+	ref := s.Clone()
+	err = dt.Run(s, func() []int {
+		if !ref.Running(p.Inst) {
+			return nil
+		}
+		ref.Step(p.Inst)
+		return append([]int(nil), ref.R...)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiffTestIdioms(t *testing.T) {
+	p, err := ParseSource(strings.NewReader(divisorSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dt := DiffTest{
+		Prog:        p.Inst,
+		At:          16, // after the loops
+		Regs:        []int{0},
+		Checkpoints: 1,
+	}
+	start := func() *State {
+		s := p.Arch.State(0, 0, 0, 10)
+		*s.IP = 1
+		return s
+	}
+	ref := func() []int { return []int{1 + 2 + 5 + 10} }
+
+	if err := dt.Run(start(), ref); err != nil {
+		t.Fatal(err)
+	}
+
+	// break idioms, the program must still run its instructions
+	defer func(defs []idiomDef) { idiomDefs = defs }(idiomDefs)
+	var broken []idiomDef
+	for _, def := range idiomDefs {
+		build := def.build
+		def.build = func(start int, b *binding) idiomFunc {
+			run := build(start, b)
+			if run == nil {
+				return nil
+			}
+			return func(r []int, maxstep int) (int, int, bool) {
+				ip, steps, ok := run(r, maxstep)
+				r[0]++
+				return ip, steps, ok
+			}
+		}
+		broken = append(broken, def)
+	}
+	idiomDefs = broken
+
+	err = dt.Run(start(), ref)
+	if _, ok := err.(*IdiomError); !ok {
+		t.Errorf("got %v; want idiom error", err)
+	}
+}
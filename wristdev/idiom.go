@@ -0,0 +1,462 @@
+package wristdev
+
+import "fmt"
+
+// Idiom is a loop of a compiled program
+// executed as a native computation.
+type Idiom struct {
+	Name string
+
+	Start, End int // instruction addresses [Start, End)
+}
+
+// IdiomError reports an idiom computing a result different
+// from that of executing the instructions of its loop.
+type IdiomError struct {
+	Idiom
+
+	Entry []int // registers at entry
+
+	Got, Want     []int // registers after the loop
+	GotIP, WantIP int
+}
+
+func (e *IdiomError) Error() string {
+	return fmt.Sprintf("idiom %s at %d from %v: got ip=%d %v; want ip=%d %v",
+		e.Name, e.Start, e.Entry, e.GotIP, e.Got, e.WantIP, e.Want)
+}
+
+// Idioms returns the idioms found in the program.
+func (c *Compiled) Idioms() []Idiom {
+	var v []Idiom
+	for _, id := range c.idioms {
+		if id != nil {
+			v = append(v, id.Idiom)
+		}
+	}
+	return v
+}
+
+// idiomFunc computes the effect of running a loop from its start on r,
+// and returns the address reached and the number of steps executed.
+// It fails without changing r if it can't compute the result,
+// or needs more than maxstep steps.
+type idiomFunc func(r []int, maxstep int) (ip, steps int, ok bool)
+
+type idiom struct {
+	Idiom
+
+	inst compiledInst // original instruction at Start
+	run  idiomFunc
+}
+
+// runIdiom runs id if the loop doesn't contain addr.
+func (c *Compiled) runIdiom(s *State, id *idiom, r []int, maxstep, addr int) (ip, steps int, ok bool) {
+	if id.Start <= addr && addr < id.End {
+		return 0, 0, false
+	}
+
+	var entry []int
+	if c.Verify {
+		entry = append(entry, r...)
+	}
+
+	ip, steps, ok = id.run(r, maxstep)
+	if !ok || !c.Verify {
+		return ip, steps, ok
+	}
+
+	n := c.arch.NReg
+	want := append([]int(nil), entry...)
+	wantIP, _ := c.plain.exec(s, want, id.Start, steps, -1)
+
+	mismatch := ip != wantIP
+	for i := 0; i < n; i++ {
+		mismatch = mismatch || r[i] != want[i]
+	}
+	if mismatch {
+		panic(&IdiomError{
+			Idiom:  id.Idiom,
+			Entry:  entry[:n],
+			Got:    append([]int(nil), r[:n]...),
+			Want:   want[:n],
+			GotIP:  ip,
+			WantIP: wantIP,
+		})
+	}
+	return ip, steps, true
+}
+
+// findIdioms replaces loops of c matching idioms.
+func (c *Compiled) findIdioms() {
+	for start := range c.code {
+		for _, def := range idiomDefs {
+			b, ok := c.match(start, def.pat)
+			if !ok {
+				continue
+			}
+			run := def.build(start, b)
+			if run == nil {
+				continue
+			}
+
+			if c.idioms == nil {
+				c.idioms = make([]*idiom, len(c.code))
+				c.plain = &Compiled{
+					arch: c.arch,
+					prog: c.prog,
+					code: append([]compiledInst(nil), c.code...),
+				}
+			}
+
+			c.idioms[start] = &idiom{
+				Idiom: Idiom{
+					Name:  def.name,
+					Start: start,
+					End:   start + len(def.pat),
+				},
+				inst: c.code[start],
+				run:  run,
+			}
+			c.code[start].op = opcIdiom
+			break
+		}
+	}
+}
+
+// idiomDef is a loop idiom.
+type idiomDef struct {
+	name string
+
+	pat []pinst
+
+	// build checks the bindings of a match at start,
+	// and returns the computation of the loop or nil.
+	build func(start int, b *binding) idiomFunc
+}
+
+// pinst is a pattern matching a compiled instruction.
+type pinst struct {
+	op      opcode
+	a, b, c poperand
+	flow    flow
+}
+
+// poperand is an operand pattern.
+type poperand struct {
+	kind  byte // see pany and friends
+	name  byte // variable name
+	value int
+}
+
+const (
+	pany   = iota // anything
+	preg          // register variable
+	pimm          // immediate variable
+	pconst        // immediate constant
+	prel          // immediate relative to the start of the loop
+)
+
+func regv(name byte) poperand  { return poperand{kind: preg, name: name} }
+func immv(name byte) poperand  { return poperand{kind: pimm, name: name} }
+func constv(v int) poperand    { return poperand{kind: pconst, value: v} }
+func relv(offset int) poperand { return poperand{kind: prel, value: offset} }
+
+// jump matches a jump to target relative to the loop start.
+func jump(target int) pinst {
+	return pinst{op: opcSeti, a: relv(target - 1), flow: flowJump}
+}
+
+// branch matches the instruction at offset adding t to the
+// instruction pointer, compiled to a jump to t+start+offset+1.
+func branch(t byte, offset int) pinst {
+	return pinst{op: opcAddi, a: regv(t), b: relv(offset), flow: flowJump}
+}
+
+// binding holds the values of pattern variables.
+type binding struct {
+	reg, imm [26]int
+	set      [26]byte // bit 0: reg set, bit 1: imm set
+}
+
+func (b *binding) Reg(name byte) int { return b.reg[name-'a'] }
+func (b *binding) Imm(name byte) int { return b.imm[name-'a'] }
+
+// distinct reports if registers of the variables are all different.
+func (b *binding) distinct(names string) bool {
+	for i := 0; i < len(names); i++ {
+		for j := i + 1; j < len(names); j++ {
+			if b.Reg(names[i]) == b.Reg(names[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// commutative reports if the arguments of op may be swapped.
+func commutative(op opcode) bool {
+	switch op {
+	case opcAddr, opcMulr, opcBanr, opcBorr, opcEqrr:
+		return true
+	}
+	return false
+}
+
+// match matches pat against the code at start.
+func (c *Compiled) match(start int, pat []pinst) (*binding, bool) {
+	if start+len(pat) > len(c.code) {
+		return nil, false
+	}
+
+	b := new(binding)
+	for k, p := range pat {
+		ci := c.code[start+k]
+		if ci.op != p.op || ci.flow != p.flow {
+			return nil, false
+		}
+
+		save := *b
+		if c.operand(b, start, p.a, ci.a) && c.operand(b, start, p.b, ci.b) &&
+			c.operand(b, start, p.c, ci.c) {
+			continue
+		}
+
+		*b = save
+		if commutative(p.op) && c.operand(b, start, p.a, ci.b) &&
+			c.operand(b, start, p.b, ci.a) && c.operand(b, start, p.c, ci.c) {
+			continue
+		}
+		return nil, false
+	}
+	return b, true
+}
+
+// operand matches operand v against p, binding variables in b.
+func (c *Compiled) operand(b *binding, start int, p poperand, v int) bool {
+	k := int(p.name - 'a')
+	switch p.kind {
+	case preg:
+		if v >= c.arch.NReg {
+			// sink or zero register
+			return false
+		}
+		if b.set[k]&1 != 0 {
+			return b.reg[k] == v
+		}
+		b.reg[k], b.set[k] = v, b.set[k]|1
+		return true
+	case pimm:
+		if b.set[k]&2 != 0 {
+			return b.imm[k] == v
+		}
+		b.imm[k], b.set[k] = v, b.set[k]|2
+		return true
+	case pconst:
+		return v == p.value
+	case prel:
+		return v == start+p.value
+	}
+	return true
+}
+
+// Limits for idioms to avoid overflows.
+const (
+	idiomMaxValue = 1 << 31
+	idiomMaxOuter = 1 << 28
+)
+
+func inRange(limit int, v ...int) bool {
+	for _, x := range v {
+		if x <= -limit || x >= limit {
+			return false
+		}
+	}
+	return true
+}
+
+var idiomDefs = []idiomDef{
+	{
+		// i = max(i, floor(x/m)) computed by
+		//
+		//	do {
+		//		t = (i+1) * m
+		//		t = t > x
+		//		if t { break }
+		//		i++
+		//	}
+		//	goto exit
+		name: "quotient",
+		pat: []pinst{
+			{op: opcAddi, a: regv('i'), b: constv(1), c: regv('t')},
+			{op: opcMuli, a: regv('t'), b: immv('m'), c: regv('t')},
+			{op: opcGtrr, a: regv('t'), b: regv('x'), c: regv('t'), flow: flowBranch},
+			branch('t', 3),
+			jump(6),
+			{op: opcSeti, a: immv('e'), flow: flowJump},
+			{op: opcAddi, a: regv('i'), b: constv(1), c: regv('i')},
+			jump(0),
+		},
+		build: buildQuotient,
+	},
+	{
+		// s += sum of divisors of n in [a, n] computed by
+		//
+		//	do {
+		//		j = 1
+		//		divisor loop
+		//		a++
+		//	} while a <= n
+		name: "divisor sum",
+		pat: append(append([]pinst{
+			{op: opcSeti, a: constv(1), c: regv('j')},
+		}, divisorLoop(1)...),
+			pinst{op: opcAddi, a: regv('a'), b: constv(1), c: regv('a')},
+			pinst{op: opcGtrr, a: regv('a'), b: regv('n'), c: regv('p'), flow: flowBranch},
+			branch('p', 12),
+			jump(0),
+		),
+		build: buildDivisorSum,
+	},
+	{
+		name:  "divisor",
+		pat:   divisorLoop(0),
+		build: buildDivisor,
+	},
+}
+
+// divisorLoop returns the pattern of the divisor loop at offset:
+//
+//	do {
+//		if a*j == n { s += a }
+//		j++
+//	} while j <= n
+func divisorLoop(offset int) []pinst {
+	return []pinst{
+		{op: opcMulr, a: regv('a'), b: regv('j'), c: regv('p')},
+		{op: opcEqrr, a: regv('p'), b: regv('n'), c: regv('p'), flow: flowBranch},
+		branch('p', offset+2),
+		jump(offset + 5),
+		{op: opcAddr, a: regv('a'), b: regv('s'), c: regv('s')},
+		{op: opcAddi, a: regv('j'), b: constv(1), c: regv('j')},
+		{op: opcGtrr, a: regv('j'), b: regv('n'), c: regv('p'), flow: flowBranch},
+		branch('p', offset+7),
+		jump(offset),
+	}
+}
+
+func buildQuotient(start int, b *binding) idiomFunc {
+	i, t, x := b.Reg('i'), b.Reg('t'), b.Reg('x')
+	m, exit := b.Imm('m'), b.Imm('e')+1
+	if !b.distinct("itx") || m <= 0 || m >= idiomMaxValue {
+		return nil
+	}
+
+	return func(r []int, maxstep int) (ip, steps int, ok bool) {
+		k, xv := r[i], r[x]
+		if !inRange(idiomMaxValue, k, xv) {
+			return 0, 0, false
+		}
+
+		q := xv / m
+		if xv%m < 0 {
+			q-- // floor
+		}
+		if q < k {
+			q = k
+		}
+
+		steps = 7*(q-k) + 5
+		if steps > maxstep {
+			return 0, 0, false
+		}
+
+		r[i], r[t] = q, 1
+		return exit, steps, true
+	}
+}
+
+func buildDivisor(start int, b *binding) idiomFunc {
+	a, j, p, n, s := b.Reg('a'), b.Reg('j'), b.Reg('p'), b.Reg('n'), b.Reg('s')
+	if !b.distinct("jps") || !b.distinct("ajps") || !b.distinct("njps") {
+		return nil
+	}
+
+	return func(r []int, maxstep int) (ip, steps int, ok bool) {
+		av, j0, nv := r[a], r[j], r[n]
+		if !inRange(idiomMaxValue, av, j0, nv) {
+			return 0, 0, false
+		}
+
+		iter := nv - j0 + 1
+		if iter < 1 {
+			iter = 1
+		}
+
+		steps = 8*iter - 1
+		if steps > maxstep {
+			return 0, 0, false
+		}
+
+		count := 0
+		switch {
+		case av == 0:
+			if nv == 0 {
+				count = iter
+			}
+		case nv%av == 0:
+			if q := nv / av; j0 <= q && q < j0+iter {
+				count = 1
+			}
+		}
+
+		r[s] += av * count
+		r[j] = j0 + iter
+		r[p] = 1
+		return start + 9, steps, true
+	}
+}
+
+func buildDivisorSum(start int, b *binding) idiomFunc {
+	a, j, p, n, s := b.Reg('a'), b.Reg('j'), b.Reg('p'), b.Reg('n'), b.Reg('s')
+	if !b.distinct("ajps") || !b.distinct("njps") {
+		return nil
+	}
+
+	return func(r []int, maxstep int) (ip, steps int, ok bool) {
+		a0, nv := r[a], r[n]
+		if a0 < 1 || nv < 1 || !inRange(idiomMaxOuter, a0, nv) {
+			return 0, 0, false
+		}
+
+		iter := nv - a0 + 1
+		if iter < 1 {
+			iter = 1
+		}
+
+		// divisor loop runs nv times for each a
+		steps = iter*(8*nv+4) - 1
+		if steps > maxstep {
+			return 0, 0, false
+		}
+
+		sum := 0
+		for d := 1; d*d <= nv; d++ {
+			if nv%d != 0 {
+				continue
+			}
+			if d >= a0 {
+				sum += d
+			}
+			if e := nv / d; e != d && e >= a0 {
+				sum += e
+			}
+		}
+
+		r[s] += sum
+		r[a] = a0 + iter
+		r[j] = nv + 1
+		r[p] = 1
+		return start + 14, steps, true
+	}
+}
@@ -0,0 +1,141 @@
+package wristdev
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// divisorSource is the program of day 19 up to the initialization.
+const divisorSource = `#ip 2
+addi 2 16 2
+seti 1 0 4
+seti 1 5 5
+mulr 4 5 1
+eqrr 1 3 1
+addr 1 2 2
+addi 2 1 2
+addr 4 0 0
+addi 5 1 5
+gtrr 5 3 1
+addr 2 1 2
+seti 2 6 2
+addi 4 1 4
+gtrr 4 3 1
+addr 1 2 2
+seti 1 7 2
+mulr 2 2 2
+`
+
+// quotientSource sets r0 to max(r0, floor(r1/256)).
+const quotientSource = `#ip 5
+addi 0 1 2
+muli 2 256 2
+gtrr 2 1 2
+addr 2 5 5
+addi 5 1 5
+seti 7 0 5
+addi 0 1 0
+seti -1 0 5
+`
+
+func TestIdioms(t *testing.T) {
+	tests := []struct {
+		src  string
+		want []Idiom
+	}{
+		{divisorSource, []Idiom{
+			{Name: "divisor sum", Start: 2, End: 16},
+			{Name: "divisor", Start: 3, End: 12},
+		}},
+		{quotientSource, []Idiom{
+			{Name: "quotient", Start: 0, End: 8},
+		}},
+		{countSource, nil},
+	}
+	for _, tt := range tests {
+		p, err := ParseSource(strings.NewReader(tt.src))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := Compile(p.Arch, p.Inst).Idioms()
+		if len(got) != len(tt.want) {
+			t.Errorf("got idioms %v; want %v", got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("got idioms %v; want %v", got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestIdiomResults(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	tests := []struct {
+		src string
+
+		// entry returns random registers at entry
+		entry func() []int
+	}{
+		{divisorSource, func() []int {
+			// outer loop
+			return []int{rng.Intn(10), 0, 2, rng.Intn(300) - 5, rng.Intn(20) - 5, 0}
+		}},
+		{divisorSource, func() []int {
+			// inner loop
+			n := rng.Intn(300) - 5
+			return []int{rng.Intn(10), 0, 3, n, rng.Intn(20) - 5, rng.Intn(n+10) - 5}
+		}},
+		{quotientSource, func() []int {
+			return []int{rng.Intn(40) - 20, rng.Intn(4000) - 2000, 0, 0, 0, 0}
+		}},
+	}
+
+	for _, tt := range tests {
+		p, err := ParseSource(strings.NewReader(tt.src))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		c := Compile(p.Arch, p.Inst)
+		c.Verify = true
+
+		for iter := 0; iter < 100; iter++ {
+			entry := tt.entry()
+
+			// stop early sometimes
+			maxstep := int(1e9)
+			if iter%2 == 1 {
+				maxstep = rng.Intn(2000)
+			}
+
+			want := p.Arch.State(entry...)
+			got := want.Clone()
+
+			wantRunning := want.RunProgram(p.Inst, maxstep)
+			gotRunning := c.Run(got, maxstep)
+
+			if gotRunning != wantRunning || !got.RegistersEqual(want) || got.Steps != want.Steps {
+				t.Fatalf("%d steps from %v: got %v after %d steps; want %v after %d steps",
+					maxstep, entry, got, got.Steps, want, want.Steps)
+			}
+		}
+	}
+}
+
+func BenchmarkIdioms(b *testing.B) {
+	p, err := ParseSource(strings.NewReader(divisorSource))
+	if err != nil {
+		b.Fatal(err)
+	}
+	c := Compile(p.Arch, p.Inst)
+	for i := 0; i < b.N; i++ {
+		s := p.Arch.State(0, 0, 1, 10551364)
+		c.Run(s, 1<<62)
+	}
+}
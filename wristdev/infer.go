@@ -0,0 +1,376 @@
+package wristdev
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CodedInstruction is an instruction with a numeric opcode.
+type CodedInstruction struct {
+	Opcode  int
+	A, B, C int
+}
+
+func (i CodedInstruction) String() string {
+	return fmt.Sprintf("%d %d %d %d", i.Opcode, i.A, i.B, i.C)
+}
+
+// Sample is an observed execution of a coded instruction.
+type Sample struct {
+	Before []int
+	Inst   CodedInstruction
+	After  []int
+
+	Line int // source line of Before, or 0 if unknown
+}
+
+func (s Sample) String() string {
+	if s.Line != 0 {
+		return fmt.Sprintf("sample at line %d", s.Line)
+	}
+	return fmt.Sprintf("sample %v: %v -> %v", s.Inst, s.Before, s.After)
+}
+
+// Matches returns the operators of ops consistent with s.
+func (s Sample) Matches(ops []Operator) []Operator {
+	var v []Operator
+	for i, ok := range s.match(ops) {
+		if ok {
+			v = append(v, ops[i])
+		}
+	}
+	return v
+}
+
+// match reports for each operator of ops if it is consistent with s.
+func (s Sample) match(ops []Operator) []bool {
+	v := make([]bool, len(ops))
+	if len(s.Before) == 0 || len(s.After) != len(s.Before) {
+		return v
+	}
+
+	arch := Arch(len(s.Before))
+	after := arch.State(s.After...)
+	for i, op := range ops {
+		state := arch.State(s.Before...)
+		state.Run(op, s.Inst.A, s.Inst.B, s.Inst.C)
+		v[i] = state.RegistersEqual(after)
+	}
+	return v
+}
+
+// OpcodeMap maps opcode numbers to operators.
+type OpcodeMap map[int]Operator
+
+// Opcodes returns the opcodes of m in increasing order.
+func (m OpcodeMap) Opcodes() []int {
+	var v []int
+	for opcode := range m {
+		v = append(v, opcode)
+	}
+	sort.Ints(v)
+	return v
+}
+
+// Decode returns the instruction for ci.
+func (m OpcodeMap) Decode(ci CodedInstruction) (Instruction, error) {
+	op, ok := m[ci.Opcode]
+	if !ok {
+		return Instruction{}, errors.Errorf("opcode %d unknown", ci.Opcode)
+	}
+	return Instruction{Op: op, A: ci.A, B: ci.B, C: ci.C}, nil
+}
+
+// DecodeProgram decodes the instructions of prog.
+func (m OpcodeMap) DecodeProgram(prog []CodedInstruction) ([]Instruction, error) {
+	v := make([]Instruction, len(prog))
+	for i, ci := range prog {
+		inst, err := m.Decode(ci)
+		if err != nil {
+			return nil, errors.Wrapf(err, "instruction %d", i)
+		}
+		v[i] = inst
+	}
+	return v, nil
+}
+
+// InferError is a contradiction found by InferOpcodes.
+type InferError struct {
+	Opcode int // opcode left without operators, or -1
+
+	// Samples holds the samples that together contradict
+	// every possible mapping.
+	Samples []Sample
+}
+
+func (e *InferError) Error() string {
+	var v []string
+	for _, s := range e.Samples {
+		v = append(v, s.String())
+	}
+	list := strings.Join(v, ", ")
+	if e.Opcode < 0 {
+		return "no consistent opcode mapping for " + list
+	}
+	return fmt.Sprintf("no operator for opcode %d consistent with %s", e.Opcode, list)
+}
+
+// InferOpcodes returns every mapping of the opcodes in samples
+// to distinct operators of Standard consistent with all samples.
+// The mapping is unique if a single mapping is returned.
+//
+// If there is no consistent mapping, the error is an *InferError
+// naming the conflicting samples.
+func InferOpcodes(samples []Sample) ([]OpcodeMap, error) {
+	return Standard.InferOpcodes(samples)
+}
+
+// InferOpcodes is like the InferOpcodes function,
+// but maps opcodes to operators of set.
+func (set *InstructionSet) InferOpcodes(samples []Sample) ([]OpcodeMap, error) {
+	ops := set.Ops()
+
+	// candidate operators per opcode, and the samples
+	// that ruled out the rest
+	type candidates struct {
+		ops    map[int]bool // indices in ops
+		reason map[int]bool // indices in samples
+	}
+	cand := make(map[int]*candidates)
+	for si, s := range samples {
+		c := cand[s.Inst.Opcode]
+		if c == nil {
+			c = &candidates{ops: make(map[int]bool), reason: make(map[int]bool)}
+			for i := range ops {
+				c.ops[i] = true
+			}
+			cand[s.Inst.Opcode] = c
+		}
+
+		match := s.match(ops)
+		reduced := false
+		for i := range c.ops {
+			if !match[i] {
+				delete(c.ops, i)
+				reduced = true
+			}
+		}
+		if reduced {
+			c.reason[si] = true
+		}
+		if len(c.ops) == 0 {
+			return nil, inferError(s.Inst.Opcode, samples, c.reason)
+		}
+	}
+
+	// operators of opcodes with a single candidate
+	// are ruled out for other opcodes
+	done := make(map[int]bool)
+	for changed := true; changed; {
+		changed = false
+		for opcode, c := range cand {
+			if len(c.ops) != 1 || done[opcode] {
+				continue
+			}
+			done[opcode], changed = true, true
+
+			var op int
+			for op = range c.ops {
+			}
+			for other, oc := range cand {
+				if other == opcode || !oc.ops[op] {
+					continue
+				}
+				delete(oc.ops, op)
+				for si := range c.reason {
+					oc.reason[si] = true
+				}
+				if len(oc.ops) == 0 {
+					return nil, inferError(other, samples, oc.reason)
+				}
+			}
+		}
+	}
+
+	// enumerate the remaining choices
+	var opcodes []int
+	for opcode := range cand {
+		opcodes = append(opcodes, opcode)
+	}
+	sort.Slice(opcodes, func(i, j int) bool {
+		a, b := len(cand[opcodes[i]].ops), len(cand[opcodes[j]].ops)
+		if a != b {
+			return a < b
+		}
+		return opcodes[i] < opcodes[j]
+	})
+
+	var maps []OpcodeMap
+	cur := make(OpcodeMap)
+	used := make(map[int]bool)
+	var search func(k int)
+	search = func(k int) {
+		if k == len(opcodes) {
+			m := make(OpcodeMap, len(cur))
+			for opcode, op := range cur {
+				m[opcode] = op
+			}
+			maps = append(maps, m)
+			return
+		}
+		opcode := opcodes[k]
+		for i := range ops {
+			if !cand[opcode].ops[i] || used[i] {
+				continue
+			}
+			used[i], cur[opcode] = true, ops[i]
+			search(k + 1)
+			delete(used, i)
+			delete(cur, opcode)
+		}
+	}
+	search(0)
+
+	if len(maps) == 0 {
+		all := make(map[int]bool)
+		for _, c := range cand {
+			for si := range c.reason {
+				all[si] = true
+			}
+		}
+		return nil, inferError(-1, samples, all)
+	}
+
+	return maps, nil
+}
+
+func inferError(opcode int, samples []Sample, reason map[int]bool) error {
+	var idx []int
+	for si := range reason {
+		idx = append(idx, si)
+	}
+	sort.Ints(idx)
+
+	e := &InferError{Opcode: opcode}
+	for _, si := range idx {
+		e.Samples = append(e.Samples, samples[si])
+	}
+	return e
+}
+
+// ParseSamples parses samples in the format
+//
+//	Before: [3, 2, 1, 1]
+//	9 2 1 2
+//	After:  [3, 2, 2, 1]
+//
+// separated by blank lines, optionally followed by
+// a program of coded instructions, one per line.
+//
+// Parse errors are reported as *SyntaxError.
+func ParseSamples(r io.Reader) (samples []Sample, prog []CodedInstruction, err error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, errors.Wrap(err, "read samples")
+	}
+
+	syntaxErr := func(i int, format string, args ...interface{}) error {
+		return &SyntaxError{
+			Line:  i + 1,
+			Col:   1,
+			Token: strings.TrimSpace(lines[i]),
+			Msg:   fmt.Sprintf(format, args...),
+		}
+	}
+
+	i := 0
+	for ; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" {
+			continue
+		}
+		if !strings.HasPrefix(line, "Before:") {
+			break
+		}
+		if i+2 >= len(lines) {
+			return nil, nil, syntaxErr(i, "incomplete sample")
+		}
+
+		before, ok := parseSampleRegs(line, "Before:")
+		if !ok {
+			return nil, nil, syntaxErr(i, "invalid registers")
+		}
+		inst, ok := parseCoded(lines[i+1])
+		if !ok {
+			return nil, nil, syntaxErr(i+1, "invalid instruction")
+		}
+		after, ok := parseSampleRegs(strings.TrimSpace(lines[i+2]), "After:")
+		if !ok {
+			return nil, nil, syntaxErr(i+2, "invalid registers")
+		}
+		if len(before) != len(after) {
+			return nil, nil, syntaxErr(i+2, "register count mismatch")
+		}
+
+		samples = append(samples, Sample{
+			Before: before,
+			Inst:   inst,
+			After:  after,
+			Line:   i + 1,
+		})
+		i += 2
+	}
+
+	for ; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "" {
+			continue
+		}
+		inst, ok := parseCoded(lines[i])
+		if !ok {
+			return nil, nil, syntaxErr(i, "invalid instruction")
+		}
+		prog = append(prog, inst)
+	}
+
+	return samples, prog, nil
+}
+
+// parseSampleRegs parses registers such as "Before: [3, 2, 1, 1]".
+func parseSampleRegs(line, prefix string) ([]int, bool) {
+	if !strings.HasPrefix(line, prefix) {
+		return nil, false
+	}
+	s := strings.TrimSpace(strings.TrimPrefix(line, prefix))
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return nil, false
+	}
+
+	var v []int
+	for _, f := range strings.Split(s[1:len(s)-1], ",") {
+		var x int
+		if _, err := fmt.Sscanf(strings.TrimSpace(f), "%d", &x); err != nil {
+			return nil, false
+		}
+		v = append(v, x)
+	}
+	return v, true
+}
+
+func parseCoded(line string) (CodedInstruction, bool) {
+	var ci CodedInstruction
+	f := strings.Fields(line)
+	if len(f) != 4 {
+		return ci, false
+	}
+	_, err := fmt.Sscanf(line, "%d %d %d %d", &ci.Opcode, &ci.A, &ci.B, &ci.C)
+	return ci, err == nil
+}
@@ -0,0 +1,150 @@
+package wristdev
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const samplesSource = `Before: [3, 2, 1, 1]
+9 2 1 2
+After:  [3, 2, 2, 1]
+
+Before: [0, 0, 0, 0]
+4 0 0 0
+After:  [1, 0, 0, 0]
+
+
+
+9 1 2 3
+4 0 0 1
+`
+
+func TestParseSamples(t *testing.T) {
+	samples, prog, err := ParseSamples(strings.NewReader(samplesSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(samples) != 2 || len(prog) != 2 {
+		t.Fatalf("got %d samples and %d instructions; want 2 and 2", len(samples), len(prog))
+	}
+	s := samples[0]
+	if s.Line != 1 || s.Inst != (CodedInstruction{9, 2, 1, 2}) || s.After[2] != 2 {
+		t.Errorf("got %+v", s)
+	}
+	if samples[1].Line != 5 {
+		t.Errorf("got line %d for second sample; want 5", samples[1].Line)
+	}
+	if prog[0] != (CodedInstruction{9, 1, 2, 3}) {
+		t.Errorf("got %v; want 9 1 2 3", prog[0])
+	}
+
+	var names []string
+	for _, op := range s.Matches(Ops()) {
+		names = append(names, op.Name())
+	}
+	sort.Strings(names)
+	if got := strings.Join(names, " "); got != "addi mulr seti" {
+		t.Errorf("got matches %s", got)
+	}
+
+	for _, src := range []string{
+		"Before: [3, 2, 1, 1]\n9 2 1\nAfter:  [3, 2, 2, 1]\n",
+		"Before: [3, 2, 1]\n9 2 1 2\nAfter:  [3, 2, 2, 1]\n",
+		"Before: [3, 2, 1, 1]\n9 2 1 2\n",
+		"1 2 3 x\n",
+	} {
+		_, _, err := ParseSamples(strings.NewReader(src))
+		if _, ok := err.(*SyntaxError); !ok {
+			t.Errorf("%q: got %v; want syntax error", src, err)
+		}
+	}
+}
+
+func TestInferOpcodes(t *testing.T) {
+	rng := rand.New(rand.NewSource(16))
+	ops := Ops()
+	perm := rng.Perm(len(ops))
+
+	var samples []Sample
+	for i := 0; i < 50*len(ops); i++ {
+		opcode := rng.Intn(len(ops))
+		s := Sample{
+			Before: make([]int, 4),
+			Inst:   CodedInstruction{opcode, rng.Intn(4), rng.Intn(4), rng.Intn(4)},
+		}
+		for r := range s.Before {
+			s.Before[r] = rng.Intn(4)
+		}
+		state := Arch(4).State(s.Before...)
+		state.Run(ops[perm[opcode]], s.Inst.A, s.Inst.B, s.Inst.C)
+		s.After = state.R
+		samples = append(samples, s)
+	}
+
+	maps, err := InferOpcodes(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(maps) != 1 {
+		t.Fatalf("got %d mappings; want 1", len(maps))
+	}
+	for opcode, op := range maps[0] {
+		if want := ops[perm[opcode]].Name(); op.Name() != want {
+			t.Errorf("opcode %d: got %s; want %s", opcode, op.Name(), want)
+		}
+	}
+}
+
+func TestInferOpcodesAmbiguous(t *testing.T) {
+	samples, _, err := ParseSamples(strings.NewReader(samplesSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// addi, mulr or seti for 9; eqir, eqri or eqrr for 4
+	maps, err := InferOpcodes(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(maps) != 9 {
+		t.Errorf("got %d mappings; want 9", len(maps))
+	}
+}
+
+func TestInferOpcodesConflict(t *testing.T) {
+	// same opcode, disjoint operators
+	src := strings.Replace(samplesSource, "4 0 0 0", "9 0 0 0", 1)
+	samples, _, err := ParseSamples(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = InferOpcodes(samples)
+	e, ok := err.(*InferError)
+	if !ok {
+		t.Fatalf("got %v; want *InferError", err)
+	}
+	if e.Opcode != 9 || len(e.Samples) != 2 {
+		t.Errorf("got %v", e)
+	}
+	if msg := e.Error(); !strings.Contains(msg, "line 1") || !strings.Contains(msg, "line 5") {
+		t.Errorf("error %q does not name the samples", msg)
+	}
+
+	// two opcodes matching only mulr
+	mulr := "Before: [5, 3, 0, 0]\n%d 0 1 2\nAfter:  [5, 3, 15, 0]\n\n"
+	src = strings.Replace(mulr, "%d", "1", 1) + strings.Replace(mulr, "%d", "2", 1)
+	samples, _, err = ParseSamples(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(samples[0].Matches(Ops())); n != 1 {
+		t.Fatalf("got %d matches; want 1", n)
+	}
+	_, err = InferOpcodes(samples)
+	if e, ok := err.(*InferError); !ok || len(e.Samples) != 2 {
+		t.Errorf("got %v; want *InferError naming both samples", err)
+	}
+}
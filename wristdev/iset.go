@@ -0,0 +1,198 @@
+package wristdev
+
+import "github.com/pkg/errors"
+
+// InstructionSet is a set of operators with distinct names.
+type InstructionSet struct {
+	ops    []Operator
+	byName map[string]Operator
+}
+
+var (
+	// Standard is the instruction set of the device.
+	Standard *InstructionSet
+
+	// Extended is Standard with the operators
+	//
+	//	divr, divi  c = a / b
+	//	modr, modi  c = a % b
+	//	shlr, shli  c = a << b
+	//	shrr, shri  c = a >> b
+	//	out a       append register a to State.Output
+	//	halt        stop the device
+	//
+	// Division by zero and negative shift counts halt the device
+	// without writing register c.
+	Extended *InstructionSet
+)
+
+// NewInstructionSet returns an instruction set of ops.
+func NewInstructionSet(ops ...Operator) (*InstructionSet, error) {
+	set := &InstructionSet{byName: make(map[string]Operator)}
+	if err := set.add(ops); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+func mustInstructionSet(ops ...Operator) *InstructionSet {
+	set, err := NewInstructionSet(ops...)
+	if err != nil {
+		panic(err)
+	}
+	return set
+}
+
+func (set *InstructionSet) add(ops []Operator) error {
+	for _, op := range ops {
+		name := op.Name()
+		if name == "" {
+			return errors.New("operator without name")
+		}
+		if _, dup := set.byName[name]; dup {
+			return errors.Errorf("duplicate operator %q", name)
+		}
+		set.ops = append(set.ops, op)
+		set.byName[name] = op
+	}
+	return nil
+}
+
+// With returns a new instruction set having the operators of set and ops.
+func (set *InstructionSet) With(ops ...Operator) (*InstructionSet, error) {
+	return NewInstructionSet(append(set.Ops(), ops...)...)
+}
+
+func (set *InstructionSet) mustWith(ops ...Operator) *InstructionSet {
+	x, err := set.With(ops...)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Op returns the operator with the specified name, or nil.
+func (set *InstructionSet) Op(name string) Operator {
+	return set.byName[name]
+}
+
+// Ops returns the operators of set.
+func (set *InstructionSet) Ops() []Operator {
+	return append([]Operator(nil), set.ops...)
+}
+
+// NewOperator returns an operator writing f(s, a, b) to register c.
+// Register arguments of f are register numbers,
+// to be read with s.Reg.
+func NewOperator(prefix, name string, at, bt ArgKind, f func(s *State, a, b int) int) Operator {
+	return customOp{
+		prefix: prefix,
+		name:   name,
+		at:     at,
+		bt:     bt,
+		run: func(s *State, a, b, c int) {
+			s.SetReg(c, f(s, a, b))
+		},
+	}
+}
+
+// NewEffectOperator returns an operator running run,
+// that is responsible for writing register c, if at all.
+func NewEffectOperator(prefix, name string, at, bt ArgKind, run func(s *State, a, b, c int)) Operator {
+	return customOp{
+		prefix: prefix,
+		name:   name,
+		at:     at,
+		bt:     bt,
+		run:    run,
+	}
+}
+
+// NewVoidOperator returns an operator running run,
+// that does not write register c. If halts is set,
+// the operator always halts the device.
+func NewVoidOperator(prefix, name string, at, bt ArgKind, halts bool, run func(s *State, a, b int)) Operator {
+	return customOp{
+		prefix: prefix,
+		name:   name,
+		at:     at,
+		bt:     bt,
+		run: func(s *State, a, b, c int) {
+			run(s, a, b)
+		},
+		void:  true,
+		halts: halts,
+	}
+}
+
+// customOp is an operator not known to Compile.
+type customOp struct {
+	prefix string
+	name   string
+
+	at, bt ArgKind
+
+	run func(s *State, a, b, c int)
+
+	void  bool // register c is not written
+	halts bool // always halts the device
+}
+
+func (o customOp) Prefix() string            { return o.prefix }
+func (o customOp) Name() string              { return o.name }
+func (o customOp) Args() (a, b ArgKind)      { return o.at, o.bt }
+func (o customOp) Run(s *State, a, b, c int) { o.run(s, a, b, c) }
+func (o customOp) Writes() bool              { return !o.void }
+func (o customOp) Halts() bool               { return o.halts }
+
+func extendedOps() []Operator {
+	var ops []Operator
+
+	// arith adds operators for f, which reports
+	// false for invalid operands
+	arith := func(prefix string, f func(a, b int) (int, bool)) {
+		run := func(s *State, a, b, c int) {
+			if v, ok := f(a, b); ok {
+				s.SetReg(c, v)
+			} else {
+				s.Halted = true
+			}
+		}
+		ops = append(ops,
+			NewEffectOperator(prefix, prefix+"r", ArgReg, ArgReg, func(s *State, a, b, c int) {
+				run(s, s.Reg(a), s.Reg(b), c)
+			}),
+			NewEffectOperator(prefix, prefix+"i", ArgReg, ArgImmediate, func(s *State, a, b, c int) {
+				run(s, s.Reg(a), b, c)
+			}))
+	}
+
+	arith("div", func(a, b int) (int, bool) {
+		if b == 0 {
+			return 0, false
+		}
+		return a / b, true
+	})
+	arith("mod", func(a, b int) (int, bool) {
+		if b == 0 {
+			return 0, false
+		}
+		return a % b, true
+	})
+	arith("shl", func(a, b int) (int, bool) {
+		return a << uint(b), b >= 0
+	})
+	arith("shr", func(a, b int) (int, bool) {
+		return a >> uint(b), b >= 0
+	})
+
+	ops = append(ops,
+		NewVoidOperator("out", "out", ArgReg, ArgUnused, false, func(s *State, a, b int) {
+			s.Output = append(s.Output, s.Reg(a))
+		}),
+		NewVoidOperator("halt", "halt", ArgUnused, ArgUnused, true, func(s *State, a, b int) {
+			s.Halted = true
+		}))
+
+	return ops
+}
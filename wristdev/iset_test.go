@@ -0,0 +1,161 @@
+package wristdev
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// gcdSource outputs the digits of gcd(r0, r1) in base 10,
+// least significant digit first.
+const gcdSource = `#ip 5
+#reg a 0
+#reg b 1
+#reg t 2
+#scratch 3
+
+loop:
+	jz b done
+	modr a b t
+	setr b 0 a
+	setr t 0 b
+	jmp loop
+done:
+	modi a 10 t
+	out t
+	divi a 10 a
+	jz a end
+	jmp done
+end:
+	halt
+	out a ; not reached
+`
+
+func TestExtended(t *testing.T) {
+	p, err := Extended.Assemble(strings.NewReader(gcdSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Assemble(strings.NewReader(gcdSource)); err == nil {
+		t.Error("standard set accepts extended operators")
+	}
+
+	for _, run := range []struct {
+		name string
+		f    func(s *State) bool
+	}{
+		{"RunProgram", func(s *State) bool { return s.RunProgram(p.Inst, 1e6) }},
+		{"Compiled", func(s *State) bool { return Compile(p.Arch, p.Inst).Run(s, 1e6) }},
+	} {
+		s := p.Arch.State(4284, 1836)
+		if run.f(s) {
+			t.Errorf("%s: still running", run.name)
+		}
+		if !s.Halted {
+			t.Errorf("%s: not halted", run.name)
+		}
+		if want := []int{2, 1, 6}; !reflect.DeepEqual(s.Output, want) {
+			t.Errorf("%s: got output %v; want %v", run.name, s.Output, want)
+		}
+	}
+}
+
+func TestExtendedFault(t *testing.T) {
+	s := Arch(2).WithSet(Extended).State(7, 0)
+	prog, err := Extended.ParseProgram([]string{"divr 0 1 0", "seti 1 0 0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.RunProgram(prog, 10) || !s.Halted || s.R[0] != 7 || s.Steps != 1 {
+		t.Errorf("got %v halted=%v after %d steps; want halt on division by zero", s, s.Halted, s.Steps)
+	}
+}
+
+func TestInstructionSet(t *testing.T) {
+	triple := NewOperator("tri", "trir", ArgReg, ArgUnused, func(s *State, a, b int) int {
+		return 3 * s.Reg(a)
+	})
+	set, err := Standard.With(triple)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(set.Ops()) != len(Ops())+1 || set.Op("addr") == nil || Op("trir") != nil {
+		t.Fatal("invalid extended set")
+	}
+
+	inst, err := set.ParseInstruction("trir 1 0 0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := Arch(2).State(0, 5)
+	s.Run(inst.Op, inst.A, inst.B, inst.C)
+	if s.R[0] != 15 {
+		t.Errorf("got %v; want r0=15", s)
+	}
+
+	if _, err := set.With(triple); err == nil {
+		t.Error("duplicate operator accepted")
+	}
+}
+
+func TestExtendedDebugger(t *testing.T) {
+	p, err := Extended.Assemble(strings.NewReader(gcdSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDebugger(p.Arch.State(12, 18), p.Inst, 10)
+
+	if ev := d.Continue(1e6); ev.Reason != StopHalt || !d.State.Halted {
+		t.Fatalf("got %v; want halt", ev.Reason)
+	}
+	if d.Step(1).Reason != StopHalt {
+		t.Error("stepped after halt")
+	}
+
+	// reverse halt and the last output
+	steps := d.Steps
+	for i := 0; i < 5; i++ {
+		d.Back()
+	}
+	if d.Halted() || d.Steps != steps-5 || !reflect.DeepEqual(d.State.Output, []int{6}) {
+		t.Errorf("got %v output %v after reversing 5 steps", d.State, d.State.Output)
+	}
+}
+
+func TestVoidOperands(t *testing.T) {
+	for _, tt := range []struct {
+		src, want string
+	}{
+		{"out 3", "out 3"},
+		{"out 3 0 0", "out 3"},
+		{"halt", "halt"},
+		{"halt 0 0 5", "halt"},
+		{"divi 3 2 1", "divi 3 2 1"},
+	} {
+		inst, err := Extended.ParseInstruction(tt.src)
+		if err != nil {
+			t.Errorf("%q: %v", tt.src, err)
+			continue
+		}
+		if got := inst.String(); got != tt.want {
+			t.Errorf("%q: got %q; want %q", tt.src, got, tt.want)
+		}
+	}
+
+	for _, src := range []string{"out", "out 3 0", "divi 3 2"} {
+		if _, err := Extended.ParseInstruction(src); err == nil {
+			t.Errorf("%q: no error", src)
+		}
+		if _, err := Extended.ParseSource(strings.NewReader(src)); err == nil {
+			t.Errorf("%q: no error from ParseSource", src)
+		}
+	}
+
+	out, halt := Extended.Op("out"), Extended.Op("halt")
+	if Writes(out) || Writes(halt) || !Writes(Op("addi")) {
+		t.Error("invalid Writes")
+	}
+	if Halts(out) || !Halts(halt) || Halts(Op("addi")) {
+		t.Error("invalid Halts")
+	}
+}
@@ -0,0 +1,148 @@
+package profile
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// WritePprof writes the profile to w as a gzipped profile.proto
+// readable by go tool pprof.
+//
+// Each executed instruction is a sample with the number
+// of executions as its value. Its call stack is the instruction
+// within its block, so that pprof reports instructions
+// as flat and blocks as cumulative entries.
+func (p *Profile) WritePprof(w io.Writer) error {
+	var e pprofEncoder
+
+	name := p.Name
+	if name == "" {
+		name = "program"
+	}
+
+	e.msg(1, func(m *pbuf) { // sample_type
+		m.int(1, e.str("steps"))
+		m.int(2, e.str("count"))
+	})
+
+	nprog := len(p.Counts)
+	for i, n := range p.Counts {
+		if n == 0 {
+			continue
+		}
+		b := p.CFG.BlockAt(i)
+		e.msg(2, func(m *pbuf) { // sample
+			m.int(1, i+1)       // location_id
+			m.int(1, nprog+b+1) // location_id
+			m.int(2, n)         // value
+		})
+	}
+
+	loc := func(id, addr, fn int) {
+		e.msg(4, func(m *pbuf) { // location
+			m.int(1, id)
+			m.int(3, addr)
+			m.msg(4, func(l *pbuf) { // line
+				l.int(1, fn)
+				l.int(2, addr+1)
+			})
+		})
+	}
+	fn := func(id int, fname string, line int) {
+		e.msg(5, func(m *pbuf) { // function
+			m.int(1, id)
+			m.int(2, e.str(fname))
+			m.int(4, e.str(name))
+			m.int(5, line)
+		})
+	}
+
+	for i, inst := range p.CFG.Prog {
+		loc(i+1, i, i+1)
+		fn(i+1, fmt.Sprintf("%d: %v", i, inst), i+1)
+	}
+	for i, b := range p.CFG.Blocks {
+		id := nprog + i + 1
+		loc(id, b.Start, id)
+		fn(id, fmt.Sprintf("%s (%d-%d)", p.blockName(i), b.Start, b.End-1), b.Start+1)
+	}
+
+	for _, s := range e.strs {
+		e.bytes(6, []byte(s)) // string_table
+	}
+
+	zw := gzip.NewWriter(w)
+	if _, err := zw.Write(e.b); err != nil {
+		return errors.Wrap(err, "write pprof")
+	}
+	return errors.Wrap(zw.Close(), "write pprof")
+}
+
+// pprofEncoder encodes a profile message
+// with its string table.
+type pprofEncoder struct {
+	pbuf
+
+	strs []string
+	idx  map[string]int
+}
+
+// str returns the index of s in the string table.
+func (e *pprofEncoder) str(s string) int {
+	if e.idx == nil {
+		e.strs = []string{""}
+		e.idx = map[string]int{"": 0}
+	}
+	if i, ok := e.idx[s]; ok {
+		return i
+	}
+	i := len(e.strs)
+	e.strs = append(e.strs, s)
+	e.idx[s] = i
+	return i
+}
+
+// pbuf is a protocol buffer message being encoded.
+type pbuf struct {
+	b []byte
+}
+
+// Protocol buffer wire types.
+const (
+	wireVarint = 0
+	wireBytes  = 2
+)
+
+func (p *pbuf) varint(x uint64) {
+	for x >= 0x80 {
+		p.b = append(p.b, byte(x)|0x80)
+		x >>= 7
+	}
+	p.b = append(p.b, byte(x))
+}
+
+func (p *pbuf) key(field, wire int) {
+	p.varint(uint64(field)<<3 | uint64(wire))
+}
+
+// int encodes an int64 or uint64 field.
+func (p *pbuf) int(field, v int) {
+	p.key(field, wireVarint)
+	p.varint(uint64(v))
+}
+
+func (p *pbuf) bytes(field int, b []byte) {
+	p.key(field, wireBytes)
+	p.varint(uint64(len(b)))
+	p.b = append(p.b, b...)
+}
+
+// msg encodes an embedded message written by f.
+func (p *pbuf) msg(field int, f func(m *pbuf)) {
+	var m pbuf
+	f(&m)
+	p.bytes(field, m.b)
+}
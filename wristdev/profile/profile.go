@@ -0,0 +1,163 @@
+// Package profile collects execution profiles of wristdev programs.
+package profile
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/tajtiattila/aoc18/wristdev"
+	"github.com/tajtiattila/aoc18/wristdev/analysis"
+)
+
+// Profiler is a wristdev.Tracer collecting an execution profile.
+type Profiler struct {
+	arch *wristdev.Architecture
+	prog []wristdev.Instruction
+
+	steps  int
+	counts []int
+	jumps  map[jump]int
+}
+
+// jump is a transfer to an instruction other than the next one.
+type jump struct {
+	from, to int
+}
+
+// New returns a profiler for prog running on arch.
+func New(arch *wristdev.Architecture, prog []wristdev.Instruction) *Profiler {
+	return &Profiler{
+		arch:   arch,
+		prog:   prog,
+		counts: make([]int, len(prog)),
+		jumps:  make(map[jump]int),
+	}
+}
+
+// Trace implements wristdev.Tracer.
+func (p *Profiler) Trace(t *wristdev.Trace) {
+	p.steps++
+	if 0 <= t.IP && t.IP < len(p.counts) {
+		p.counts[t.IP]++
+	}
+	if t.Next != t.IP+1 {
+		p.jumps[jump{t.IP, t.Next}]++
+	}
+}
+
+// Profile is an execution profile.
+type Profile struct {
+	// Name is the file name of the program used in pprof output.
+	Name string
+
+	CFG *analysis.CFG
+
+	Steps int
+
+	// Counts holds the number of times each instruction was executed.
+	Counts []int
+
+	// Blocks holds the number of times each block of CFG was entered
+	// at its first instruction.
+	Blocks []int
+
+	// Edges holds control transfers between blocks,
+	// in descending order of counts.
+	Edges []Edge
+}
+
+// Edge is a control transfer between blocks.
+type Edge struct {
+	From, To int // block indices, To may be analysis.Exit
+
+	Count int
+}
+
+// Profile returns the profile collected so far.
+func (p *Profiler) Profile() *Profile {
+	g := analysis.Build(p.arch, p.prog)
+	prof := &Profile{
+		CFG:    g,
+		Steps:  p.steps,
+		Counts: append([]int(nil), p.counts...),
+		Blocks: make([]int, len(g.Blocks)),
+	}
+
+	for i, b := range g.Blocks {
+		prof.Blocks[i] = p.counts[b.Start]
+	}
+
+	edges := make(map[[2]int]int)
+	jumped := make([]int, len(p.prog))
+	for j, n := range p.jumps {
+		edges[[2]int{g.BlockAt(j.from), g.BlockAt(j.to)}] += n
+		if 0 <= j.from && j.from < len(jumped) {
+			jumped[j.from] += n
+		}
+	}
+
+	// falling through the last instruction
+	for _, b := range g.Blocks {
+		last := b.End - 1
+		if n := p.counts[last] - jumped[last]; n > 0 {
+			edges[[2]int{b.Index, g.BlockAt(b.End)}] += n
+		}
+	}
+
+	for k, n := range edges {
+		prof.Edges = append(prof.Edges, Edge{From: k[0], To: k[1], Count: n})
+	}
+	sort.Slice(prof.Edges, func(i, j int) bool {
+		a, b := prof.Edges[i], prof.Edges[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.To < b.To
+	})
+
+	return prof
+}
+
+// blockName returns the name of block i of p.
+func (p *Profile) blockName(i int) string {
+	if i == analysis.Exit {
+		return "exit"
+	}
+	return analysis.Label(p.CFG.Blocks[i].Start)
+}
+
+func (p *Profile) percent(n int) float64 {
+	if p.Steps == 0 {
+		return 0
+	}
+	return 100 * float64(n) / float64(p.Steps)
+}
+
+// WriteText writes the profile to w in text form.
+func (p *Profile) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintf(tw, "%d steps\n", p.Steps)
+
+	fmt.Fprintln(tw, "\naddr\tcount\t%\t")
+	for i, n := range p.Counts {
+		fmt.Fprintf(tw, "%d\t%d\t%.1f\t  %v\n", i, n, p.percent(n), p.CFG.Prog[i])
+	}
+
+	fmt.Fprintln(tw, "\nblock\trange\tcount\t")
+	for i, b := range p.CFG.Blocks {
+		fmt.Fprintf(tw, "%s\t%d-%d\t%d\t\n", p.blockName(i), b.Start, b.End-1, p.Blocks[i])
+	}
+
+	fmt.Fprintln(tw, "\nfrom\tto\tcount\t")
+	for _, e := range p.Edges {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t\n", p.blockName(e.From), p.blockName(e.To), e.Count)
+	}
+
+	return tw.Flush()
+}
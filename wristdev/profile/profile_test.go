@@ -0,0 +1,92 @@
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tajtiattila/aoc18/wristdev"
+	"github.com/tajtiattila/aoc18/wristdev/analysis"
+)
+
+// countSource counts r0 to 3.
+const countSource = `#ip 5
+seti 0 0 0
+addi 0 1 0
+gtri 0 2 1
+addr 1 5 5
+seti 0 0 5
+`
+
+func runProfile(t *testing.T) *Profile {
+	p, err := wristdev.ParseSource(strings.NewReader(countSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof := New(p.Arch, p.Inst)
+	s := p.Arch.State()
+	s.Tracer = prof
+	s.RunProgram(p.Inst, 1000)
+	return prof.Profile()
+}
+
+func TestProfile(t *testing.T) {
+	p := runProfile(t)
+
+	if p.Steps != 12 {
+		t.Errorf("got %d steps; want 12", p.Steps)
+	}
+	if want := []int{1, 3, 3, 3, 2}; !reflect.DeepEqual(p.Counts, want) {
+		t.Errorf("got counts %v; want %v", p.Counts, want)
+	}
+	if want := []int{1, 3, 2}; !reflect.DeepEqual(p.Blocks, want) {
+		t.Errorf("got block counts %v; want %v", p.Blocks, want)
+	}
+	want := []Edge{
+		{From: 1, To: 2, Count: 2},
+		{From: 2, To: 1, Count: 2},
+		{From: 0, To: 1, Count: 1},
+		{From: 1, To: analysis.Exit, Count: 1},
+	}
+	if !reflect.DeepEqual(p.Edges, want) {
+		t.Errorf("got edges %v; want %v", p.Edges, want)
+	}
+
+	var buf bytes.Buffer
+	if err := p.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	text := strings.Join(strings.Fields(buf.String()), " ")
+	for _, s := range []string{"12 steps", "L1 1-3 3", "L1 exit 1", "3 3 25.0 addr 1 5 5"} {
+		if !strings.Contains(text, s) {
+			t.Errorf("text output lacks %q:\n%s", s, buf.String())
+		}
+	}
+}
+
+func TestWritePprof(t *testing.T) {
+	p := runProfile(t)
+	p.Name = "count.asm"
+
+	var buf bytes.Buffer
+	if err := p.WritePprof(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"steps", "count.asm", "3: addr 1 5 5", "L1 (1-3)"} {
+		if !bytes.Contains(raw, []byte(s)) {
+			t.Errorf("pprof output lacks %q", s)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package wristdev
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Snapshot is a copy of a State with the hash of its program,
+// that can be encoded as JSON or in binary form.
+type Snapshot struct {
+	NReg  int `json:"nreg"`
+	IPReg int `json:"ipreg"` // register bound to the instruction pointer, or -1
+
+	IP int   `json:"ip"`
+	R  []int `json:"r"`
+
+	Steps  int   `json:"steps"`
+	Halted bool  `json:"halted,omitempty"`
+	Output []int `json:"output,omitempty"`
+
+	// Program is the hash of the program, see ProgramHash.
+	Program string `json:"program"`
+}
+
+// ErrProgramMismatch is the cause of errors restoring
+// snapshots of other programs or architectures.
+var ErrProgramMismatch = errors.New("snapshot of another program")
+
+// ProgramHash returns the hex encoded SHA-256 hash of prog.
+func ProgramHash(prog []Instruction) string {
+	h := sha256.New()
+	for _, inst := range prog {
+		h.Write([]byte(inst.String()))
+		h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Snapshot returns a snapshot of s running prog.
+func (s *State) Snapshot(prog []Instruction) *Snapshot {
+	return s.snapshot(ProgramHash(prog))
+}
+
+// snapshot returns a snapshot of s running the program with hash.
+func (s *State) snapshot(hash string) *Snapshot {
+	sn := &Snapshot{
+		NReg:    s.Arch.NReg,
+		IPReg:   -1,
+		IP:      *s.IP,
+		R:       append([]int(nil), s.R...),
+		Steps:   s.Steps,
+		Halted:  s.Halted,
+		Output:  append([]int(nil), s.Output...),
+		Program: hash,
+	}
+	if s.Arch.IP.IsRegister {
+		sn.IPReg = s.Arch.IP.Index
+	}
+	return sn
+}
+
+// Restore returns the state of sn for running prog on arch.
+// It fails with ErrProgramMismatch as the cause
+// if sn was taken of another program or architecture,
+// and rejects snapshots with inconsistent registers.
+func (sn *Snapshot) Restore(arch *Architecture, prog []Instruction) (*State, error) {
+	ipreg := -1
+	if arch.IP.IsRegister {
+		ipreg = arch.IP.Index
+	}
+	switch {
+	case sn.Program != ProgramHash(prog):
+		return nil, errors.Wrapf(ErrProgramMismatch, "program hash %.12s", sn.Program)
+	case sn.NReg != arch.NReg || sn.IPReg != ipreg:
+		return nil, errors.Wrapf(ErrProgramMismatch, "architecture %d/%d", sn.NReg, sn.IPReg)
+	case len(sn.R) != sn.NReg:
+		return nil, errors.Errorf("snapshot has %d registers, want %d", len(sn.R), sn.NReg)
+	case sn.IPReg >= 0 && sn.IP != sn.R[sn.IPReg]:
+		return nil, errors.Errorf("corrupt snapshot: ip %d but r%d is %d", sn.IP, sn.IPReg, sn.R[sn.IPReg])
+	}
+
+	s := arch.State(sn.R...)
+	*s.IP = sn.IP
+	s.Steps = sn.Steps
+	s.Halted = sn.Halted
+	s.Output = append([]int(nil), sn.Output...)
+	return s, nil
+}
+
+// snapshotMagic starts binary snapshots, and includes the version.
+const snapshotMagic = "wdsnap1\n"
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (sn *Snapshot) MarshalBinary() ([]byte, error) {
+	hash, err := hex.DecodeString(sn.Program)
+	if err != nil || len(hash) != sha256.Size {
+		return nil, errors.Errorf("invalid program hash %q", sn.Program)
+	}
+
+	buf := []byte(snapshotMagic)
+	var tmp [binary.MaxVarintLen64]byte
+	put := func(v int) {
+		n := binary.PutVarint(tmp[:], int64(v))
+		buf = append(buf, tmp[:n]...)
+	}
+	putSlice := func(v []int) {
+		put(len(v))
+		for _, x := range v {
+			put(x)
+		}
+	}
+
+	put(sn.NReg)
+	put(sn.IPReg)
+	put(sn.IP)
+	putSlice(sn.R)
+	put(sn.Steps)
+	if sn.Halted {
+		put(1)
+	} else {
+		put(0)
+	}
+	putSlice(sn.Output)
+	buf = append(buf, hash...)
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (sn *Snapshot) UnmarshalBinary(data []byte) error {
+	if !bytes.HasPrefix(data, []byte(snapshotMagic)) {
+		return errors.New("invalid snapshot header")
+	}
+	r := bytes.NewReader(data[len(snapshotMagic):])
+
+	var err error
+	get := func() int {
+		if err != nil {
+			return 0
+		}
+		var v int64
+		v, err = binary.ReadVarint(r)
+		return int(v)
+	}
+	getSlice := func() []int {
+		n := get()
+		if err == nil && (n < 0 || n > r.Len()) {
+			err = errors.New("invalid length")
+		}
+		if err != nil || n == 0 {
+			return nil
+		}
+		v := make([]int, n)
+		for i := range v {
+			v[i] = get()
+		}
+		return v
+	}
+
+	var x Snapshot
+	x.NReg = get()
+	x.IPReg = get()
+	x.IP = get()
+	x.R = getSlice()
+	x.Steps = get()
+	x.Halted = get() != 0
+	x.Output = getSlice()
+	if err != nil {
+		return errors.Wrap(err, "invalid snapshot")
+	}
+
+	if r.Len() != sha256.Size {
+		return errors.New("invalid snapshot program hash")
+	}
+	hash := make([]byte, sha256.Size)
+	r.Read(hash)
+	x.Program = hex.EncodeToString(hash)
+
+	*sn = x
+	return nil
+}
+
+// SaveSnapshot writes sn in binary form to the file fn.
+// The file is replaced only after the snapshot is completely
+// written, so that an interrupted save keeps the last snapshot.
+func SaveSnapshot(fn string, sn *Snapshot) error {
+	data, err := sn.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(fn), filepath.Base(fn)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "save snapshot")
+	}
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), fn)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return errors.Wrap(err, "save snapshot")
+	}
+	return nil
+}
+
+// LoadSnapshot reads a snapshot written by SaveSnapshot from the file fn.
+func LoadSnapshot(fn string) (*Snapshot, error) {
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return nil, errors.Wrap(err, "load snapshot")
+	}
+	sn := new(Snapshot)
+	if err := sn.UnmarshalBinary(data); err != nil {
+		return nil, errors.Wrap(err, fn)
+	}
+	return sn, nil
+}
+
+// RunCheckpointed runs the program on s like Run,
+// passing a snapshot to save after every n steps
+// while the program is running.
+// It stops at the first error returned by save.
+func (c *Compiled) RunCheckpointed(s *State, maxstep, n int, save func(*Snapshot) error) (bool, error) {
+	if n <= 0 {
+		panic("invalid checkpoint interval")
+	}
+
+	hash := ProgramHash(c.prog)
+	for maxstep > 0 {
+		chunk := n
+		if chunk > maxstep {
+			chunk = maxstep
+		}
+		start := s.Steps
+		if !c.Run(s, chunk) {
+			return false, nil
+		}
+		maxstep -= s.Steps - start
+
+		if err := save(s.snapshot(hash)); err != nil {
+			return true, err
+		}
+	}
+	return s.Running(c.prog), nil
+}
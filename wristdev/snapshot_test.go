@@ -0,0 +1,139 @@
+package wristdev
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestSnapshotEncoding(t *testing.T) {
+	p, err := ParseSource(strings.NewReader(countSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := p.Arch.State(-5, 1<<40)
+	s.RunProgram(p.Inst, 17)
+	s.Output = []int{3, -1}
+	sn := s.Snapshot(p.Inst)
+
+	data, err := sn.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bin Snapshot
+	if err := bin.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&bin, sn) {
+		t.Errorf("binary: got %+v; want %+v", bin, *sn)
+	}
+	for n := 0; n < len(data); n++ {
+		if err := new(Snapshot).UnmarshalBinary(data[:n]); err == nil {
+			t.Fatalf("truncated snapshot of %d bytes accepted", n)
+		}
+	}
+
+	data, err = json.Marshal(sn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var js Snapshot
+	if err := json.Unmarshal(data, &js); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&js, sn) {
+		t.Errorf("json: got %+v; want %+v", js, *sn)
+	}
+
+	r, err := sn.Restore(p.Arch, p.Inst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.String() != s.String() || r.Steps != 17 || !reflect.DeepEqual(r.Output, s.Output) {
+		t.Errorf("restored %v after %d steps; want %v", r, r.Steps, s)
+	}
+	if r.IP != &r.R[p.Arch.IP.Index] {
+		t.Error("restored instruction pointer is not bound to its register")
+	}
+}
+
+func TestSnapshotMismatch(t *testing.T) {
+	p, err := ParseSource(strings.NewReader(countSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sn := p.Arch.State().Snapshot(p.Inst)
+
+	other := append([]Instruction(nil), p.Inst...)
+	other[2].B++
+	if _, err := sn.Restore(p.Arch, other); errors.Cause(err) != ErrProgramMismatch {
+		t.Errorf("other program: got %v", err)
+	}
+	if _, err := sn.Restore(ArchWithIP(6, 4), p.Inst); errors.Cause(err) != ErrProgramMismatch {
+		t.Errorf("other architecture: got %v", err)
+	}
+
+	corrupt := *sn
+	corrupt.R = append([]int(nil), sn.R...)
+	corrupt.R[5] = 3
+	if _, err := corrupt.Restore(p.Arch, p.Inst); err == nil || errors.Cause(err) == ErrProgramMismatch {
+		t.Errorf("instruction pointer differing from its register: got %v", err)
+	}
+}
+
+func TestRunCheckpointed(t *testing.T) {
+	p, err := ParseSource(strings.NewReader(countSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := Compile(p.Arch, p.Inst)
+
+	want := p.Arch.State()
+	code.Run(want, 1e9)
+
+	fn := filepath.Join(t.TempDir(), "count.snap")
+	errCrash := errors.New("crash")
+
+	// interrupted after the third checkpoint
+	saves := 0
+	s := p.Arch.State()
+	_, err = code.RunCheckpointed(s, 1e9, 10000, func(sn *Snapshot) error {
+		if err := SaveSnapshot(fn, sn); err != nil {
+			return err
+		}
+		saves++
+		if saves == 3 {
+			return errCrash
+		}
+		return nil
+	})
+	if err != errCrash {
+		t.Fatalf("got %v; want crash", err)
+	}
+
+	sn, err := LoadSnapshot(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sn.Steps != 30000 {
+		t.Errorf("got snapshot after %d steps; want 30000", sn.Steps)
+	}
+	s, err = sn.Restore(p.Arch, p.Inst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	running, err := code.RunCheckpointed(s, 1e9, 10000, func(sn *Snapshot) error {
+		return SaveSnapshot(fn, sn)
+	})
+	if running || err != nil {
+		t.Fatalf("got running=%v err=%v after resume", running, err)
+	}
+	if s.String() != want.String() || s.Steps != want.Steps {
+		t.Errorf("got %v after %d steps; want %v after %d", s, s.Steps, want, want.Steps)
+	}
+}
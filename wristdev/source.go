@@ -0,0 +1,280 @@
+package wristdev
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// SourceRegisters is the number of registers
+// of architectures of programs parsed by ParseSource.
+const SourceRegisters = 6
+
+// Program is a device program parsed from source.
+type Program struct {
+	// Arch is the architecture of the program,
+	// with the instruction pointer bound by the #ip directive.
+	Arch *Architecture
+
+	Inst []Instruction
+
+	// Lines holds the source line number of each instruction.
+	Lines []int
+
+	// Comments holds the comment of each instruction
+	// without the leading ';', or an empty string.
+	Comments []string
+}
+
+// SyntaxError is a parse error in program source.
+type SyntaxError struct {
+	Line, Col int // position of Token, starting at 1
+
+	Token string // offending token, empty at end of line
+	Msg   string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Col, e.Msg)
+}
+
+// ParseSource parses a program from r.
+//
+// The source consists of an optional "#ip N" directive binding
+// the instruction pointer to register N, followed by instructions
+// such as "addi 1 2 3", one per line.
+// Comments start with ';' and run until the end of the line.
+//
+// Parse errors are reported as *SyntaxError.
+func ParseSource(r io.Reader) (*Program, error) {
+	return Standard.ParseSource(r)
+}
+
+// ParseSource is like the ParseSource function,
+// but accepts the operators of set.
+func (set *InstructionSet) ParseSource(r io.Reader) (*Program, error) {
+	p := &Program{
+		Arch: Arch(SourceRegisters).WithSet(set),
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineno := 0
+	for scanner.Scan() {
+		lineno++
+		if err := p.parseLine(lineno, scanner.Text()); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "read source")
+	}
+
+	return p, nil
+}
+
+// sourceToken is a token of a source line.
+type sourceToken struct {
+	col int // column starting at 1
+	s   string
+}
+
+// sourceLine is a source line split into tokens.
+type sourceLine struct {
+	no   int // line number starting at 1
+	text string
+
+	toks    []sourceToken
+	comment string
+}
+
+func splitSourceLine(no int, text string) *sourceLine {
+	l := &sourceLine{no: no, text: text}
+
+	code := text
+	if i := strings.IndexByte(code, ';'); i >= 0 {
+		code, l.comment = code[:i], strings.TrimSpace(code[i+1:])
+	}
+
+	start := -1
+	for i, r := range code + " " {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				l.toks = append(l.toks, sourceToken{col: start + 1, s: code[start:i]})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+
+	return l
+}
+
+// errAt returns a *SyntaxError at token i.
+// If i is past the last token, the error points to the end of the code.
+func (l *sourceLine) errAt(i int, format string, args ...interface{}) error {
+	e := &SyntaxError{
+		Line: l.no,
+		Msg:  fmt.Sprintf(format, args...),
+	}
+	if i < len(l.toks) {
+		e.Col, e.Token = l.toks[i].col, l.toks[i].s
+	} else {
+		e.Col = len(strings.TrimRightFunc(l.text, unicode.IsSpace)) + 1
+		if n := len(l.toks); n != 0 {
+			last := l.toks[n-1]
+			e.Col = last.col + len(last.s)
+		}
+	}
+	return e
+}
+
+// number parses token i as an integer.
+func (l *sourceLine) number(i int) (int, error) {
+	v, err := strconv.Atoi(l.toks[i].s)
+	if err != nil {
+		return 0, l.errAt(i, "invalid number %q", l.toks[i].s)
+	}
+	return v, nil
+}
+
+// parseIP parses the #ip directive in l for p.
+func (p *Program) parseIP(l *sourceLine) error {
+	switch {
+	case p.Arch.IP.IsRegister:
+		return l.errAt(0, "duplicate #ip directive")
+	case len(p.Inst) != 0:
+		return l.errAt(0, "#ip directive after instructions")
+	case len(l.toks) < 2:
+		return l.errAt(2, "#ip register missing")
+	case len(l.toks) > 2:
+		return l.errAt(2, "unexpected %q", l.toks[2].s)
+	}
+
+	ip, err := l.number(1)
+	if err != nil {
+		return err
+	}
+	if err := p.checkReg(l, 1, ip); err != nil {
+		return err
+	}
+
+	p.Arch = ArchWithIP(p.Arch.NReg, ip).WithSet(p.Arch.Set)
+	return nil
+}
+
+// checkReg checks register v at token i.
+func (p *Program) checkReg(l *sourceLine, i, v int) error {
+	if v < 0 || v >= p.Arch.NReg {
+		return l.errAt(i, "register %d out of range", v)
+	}
+	return nil
+}
+
+// add adds inst from l to p.
+func (p *Program) add(l *sourceLine, inst Instruction, comment string) {
+	p.Inst = append(p.Inst, inst)
+	p.Lines = append(p.Lines, l.no)
+	p.Comments = append(p.Comments, comment)
+}
+
+const instArgs = 3 // number of instruction arguments
+
+// argKinds returns the kinds of the arguments of op.
+func argKinds(op Operator) [instArgs]ArgKind {
+	ak, bk := op.Args()
+	ck := ArgReg
+	if !Writes(op) {
+		ck = ArgUnused
+	}
+	return [instArgs]ArgKind{ak, bk, ck}
+}
+
+// operands returns the number of arguments used by op,
+// up to the last one used. Instructions may omit the rest.
+func operands(op Operator) int {
+	kinds := argKinds(op)
+	n := instArgs
+	for n > 0 && kinds[n-1] == ArgUnused {
+		n--
+	}
+	return n
+}
+
+func (p *Program) parseLine(lineno int, text string) error {
+	l := splitSourceLine(lineno, text)
+	if len(l.toks) == 0 {
+		return nil
+	}
+
+	if name := l.toks[0].s; strings.HasPrefix(name, "#") {
+		if name != "#ip" {
+			return l.errAt(0, "unknown directive %q", name)
+		}
+		return p.parseIP(l)
+	}
+
+	op := p.Arch.InstructionSet().Op(l.toks[0].s)
+	if op == nil {
+		return l.errAt(0, "unknown op %q", l.toks[0].s)
+	}
+
+	// operands not used by op may be omitted
+	nargs := len(l.toks) - 1
+	if n := operands(op); nargs != n {
+		if nargs < n {
+			return l.errAt(len(l.toks), "%s needs %d operands", op.Name(), n)
+		}
+		if nargs > instArgs {
+			return l.errAt(1+instArgs, "unexpected %q", l.toks[1+instArgs].s)
+		}
+		if nargs != instArgs {
+			return l.errAt(1+n, "unexpected %q", l.toks[1+n].s)
+		}
+	}
+
+	kinds := argKinds(op)
+
+	var args [instArgs]int
+	for i := range args[:nargs] {
+		v, err := l.number(1 + i)
+		if err != nil {
+			return err
+		}
+		if kinds[i] == ArgReg {
+			if err := p.checkReg(l, 1+i, v); err != nil {
+				return err
+			}
+		}
+		args[i] = v
+	}
+
+	p.add(l, Instruction{
+		Op: op,
+		A:  args[0],
+		B:  args[1],
+		C:  args[2],
+	}, l.comment)
+	return nil
+}
+
+// WriteSource writes p to w in the format parsed by ParseSource.
+func (p *Program) WriteSource(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if p.Arch.IP.IsRegister {
+		fmt.Fprintf(bw, "#ip %d\n", p.Arch.IP.Index)
+	}
+	for i, inst := range p.Inst {
+		bw.WriteString(inst.String())
+		if i < len(p.Comments) && p.Comments[i] != "" {
+			fmt.Fprintf(bw, " ; %s", p.Comments[i])
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
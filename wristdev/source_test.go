@@ -0,0 +1,75 @@
+package wristdev
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSource(t *testing.T) {
+	src := `; sample program
+#ip 0
+seti 5 0 1 ; five
+seti 6 0 2
+
+addi 0 1 0
+addr 1 2 3
+`
+	p, err := ParseSource(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !p.Arch.IP.IsRegister || p.Arch.IP.Index != 0 {
+		t.Errorf("got IP %+v; want register 0", p.Arch.IP)
+	}
+
+	if len(p.Inst) != 4 {
+		t.Fatalf("got %d instructions; want 4", len(p.Inst))
+	}
+
+	if s := p.Inst[3].String(); s != "addr 1 2 3" {
+		t.Errorf("got instruction %q", s)
+	}
+
+	wantLines := []int{3, 4, 6, 7}
+	for i, l := range wantLines {
+		if p.Lines[i] != l {
+			t.Errorf("instruction %d: got line %d; want %d", i, p.Lines[i], l)
+		}
+	}
+
+	if p.Comments[0] != "five" || p.Comments[1] != "" {
+		t.Errorf("got comments %q", p.Comments)
+	}
+}
+
+func TestParseSourceErrors(t *testing.T) {
+	tests := []struct {
+		src       string
+		line, col int
+		token     string
+	}{
+		{"seti 5 0 1\n  adx 1 2 3", 2, 3, "adx"},
+		{"addi 1 x 3", 1, 8, "x"},
+		{"addi 1 2", 1, 9, ""},
+		{"addi 1 2 3 4", 1, 12, "4"},
+		{"addr 1 9 3", 1, 8, "9"},
+		{"#ip 1\n#ip 2", 2, 1, "#ip"},
+		{"seti 1 2 3\n#ip 2", 2, 1, "#ip"},
+		{"#ip 6", 1, 5, "6"},
+		{"#foo 2", 1, 1, "#foo"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseSource(strings.NewReader(tt.src))
+		se, ok := err.(*SyntaxError)
+		if !ok {
+			t.Errorf("%q: got error %v; want syntax error", tt.src, err)
+			continue
+		}
+		if se.Line != tt.line || se.Col != tt.col || se.Token != tt.token {
+			t.Errorf("%q: got error at %d:%d %q; want %d:%d %q", tt.src,
+				se.Line, se.Col, se.Token, tt.line, tt.col, tt.token)
+		}
+	}
+}
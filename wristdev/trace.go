@@ -0,0 +1,75 @@
+package wristdev
+
+// Tracer receives instructions executed by a State.
+type Tracer interface {
+	Trace(t *Trace)
+}
+
+// TracerFunc is a function used as a Tracer.
+type TracerFunc func(t *Trace)
+
+func (f TracerFunc) Trace(t *Trace) { f(t) }
+
+// Trace is an instruction executed by a State.
+//
+// It is valid only during the call to Tracer.Trace.
+type Trace struct {
+	Step int // number of instructions executed before
+
+	IP   int // address of the instruction
+	Next int // address of the next instruction
+
+	Op      Operator
+	A, B, C int
+
+	// Deltas holds the registers changed by the instruction.
+	// The increment of the instruction pointer
+	// after the instruction is not included.
+	Deltas []RegDelta
+}
+
+// Instruction returns the executed instruction.
+func (t *Trace) Instruction() Instruction {
+	return Instruction{Op: t.Op, A: t.A, B: t.B, C: t.C}
+}
+
+// RegDelta is a change of a register.
+type RegDelta struct {
+	Reg      int
+	Old, New int
+}
+
+// traceBuf holds buffers reused for tracing.
+type traceBuf struct {
+	old []int
+	t   Trace
+}
+
+func (s *State) runTraced(op Operator, a, b, c int) {
+	tb := &s.trace
+	tb.old = append(tb.old[:0], s.R...)
+
+	t := &tb.t
+	*t = Trace{
+		Step:   s.Steps,
+		IP:     *s.IP,
+		Op:     op,
+		A:      a,
+		B:      b,
+		C:      c,
+		Deltas: t.Deltas[:0],
+	}
+
+	op.Run(s, a, b, c)
+	for i, v := range s.R {
+		if v != tb.old[i] {
+			t.Deltas = append(t.Deltas, RegDelta{Reg: i, Old: tb.old[i], New: v})
+		}
+	}
+
+	*(s.IP)++
+	s.Steps++
+
+	t.Next = *s.IP
+	s.Tracer.Trace(t)
+}
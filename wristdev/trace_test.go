@@ -0,0 +1,56 @@
+package wristdev
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	src := strings.Replace(countSource, "99999", "2", 1)
+	p, err := ParseSource(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v []Trace
+	s := p.Arch.State()
+	s.Tracer = TracerFunc(func(t *Trace) {
+		tt := *t
+		tt.Deltas = append([]RegDelta(nil), t.Deltas...)
+		v = append(v, tt)
+	})
+	s.RunProgram(p.Inst, 1000)
+
+	if len(v) != 12 || s.Steps != 12 {
+		t.Fatalf("got %d traces and %d steps; want 12", len(v), s.Steps)
+	}
+	for i, tr := range v {
+		if tr.Step != i {
+			t.Fatalf("trace %d: got step %d", i, tr.Step)
+		}
+	}
+
+	// jump closing the loop
+	tr := v[4]
+	if tr.IP != 4 || tr.Next != 1 || tr.Instruction().String() != "seti 0 0 5" {
+		t.Errorf("got %+v; want jump from 4 to 1", tr)
+	}
+	if want := []RegDelta{{Reg: 5, Old: 4, New: 0}}; !reflect.DeepEqual(tr.Deltas, want) {
+		t.Errorf("got deltas %v; want %v", tr.Deltas, want)
+	}
+
+	// increment of r0
+	if want := []RegDelta{{Reg: 0, Old: 0, New: 1}}; !reflect.DeepEqual(v[1].Deltas, want) {
+		t.Errorf("got deltas %v; want %v", v[1].Deltas, want)
+	}
+
+	// compiled programs run the original instructions when traced
+	n := len(v)
+	s = p.Arch.State()
+	s.Tracer = TracerFunc(func(t *Trace) { n-- })
+	Compile(p.Arch, p.Inst).Run(s, 1000)
+	if n != 0 || s.Steps != 12 {
+		t.Errorf("compiled: got %d steps, %d traces missing", s.Steps, n)
+	}
+}
@@ -3,6 +3,8 @@ package wristdev
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -10,6 +12,9 @@ import (
 type Architecture struct {
 	NReg int // number of registers
 
+	// Set is the instruction set, or nil for Standard.
+	Set *InstructionSet
+
 	// instruction pointer register
 	IP struct {
 		IsRegister bool
@@ -35,6 +40,21 @@ func ArchWithIP(numRegisters, ipIndex int) *Architecture {
 	return a
 }
 
+// WithSet returns a copy of a using set.
+func (a *Architecture) WithSet(set *InstructionSet) *Architecture {
+	b := *a
+	b.Set = set
+	return &b
+}
+
+// InstructionSet returns the instruction set of a.
+func (a *Architecture) InstructionSet() *InstructionSet {
+	if a.Set == nil {
+		return Standard
+	}
+	return a.Set
+}
+
 // State creates a new empty state for a
 // from the values specified.
 func (a *Architecture) State(values ...int) *State {
@@ -65,6 +85,20 @@ type State struct {
 
 	R  []int // registers
 	IP *int
+
+	// Tracer, if not nil, receives each instruction run by s.
+	Tracer Tracer
+
+	Steps int // number of instructions run
+
+	// Halted is set by operators stopping the device,
+	// such as halt and division by zero of Extended.
+	Halted bool
+
+	// Output holds values written by operators such as out of Extended.
+	Output []int
+
+	trace traceBuf
 }
 
 func (old *State) Clone() *State {
@@ -83,6 +117,9 @@ func (old *State) Clone() *State {
 	}
 
 	copy(s.R, old.R)
+	s.Steps = old.Steps
+	s.Halted = old.Halted
+	s.Output = append([]int(nil), old.Output...)
 
 	return s
 }
@@ -109,33 +146,54 @@ func (s *State) String() string {
 }
 
 func (s *State) Run(op Operator, a, b, c int) {
+	if s.Tracer != nil {
+		s.runTraced(op, a, b, c)
+		return
+	}
+
 	op.Run(s, a, b, c)
 	*(s.IP)++
+	s.Steps++
 }
 
 // Step steps once in prog, and reports if the process is still running.
 func (s *State) Step(prog []Instruction) bool {
-	if *s.IP < 0 || *s.IP >= len(prog) {
+	if !s.Running(prog) {
 		return false
 	}
 
 	inst := prog[*s.IP]
 	s.Run(inst.Op, inst.A, inst.B, inst.C)
 
-	return 0 <= *s.IP && *s.IP < len(prog)
+	return s.Running(prog)
 }
 
 func (s *State) RunProgram(prog []Instruction, maxstep int) bool {
-	if *s.IP < 0 || *s.IP >= len(prog) {
-		return false
-	}
-
-	for i := 0; i < maxstep && 0 <= *s.IP && *s.IP < len(prog); i++ {
+	for i := 0; i < maxstep && s.Running(prog); i++ {
 		inst := prog[*s.IP]
 		s.Run(inst.Op, inst.A, inst.B, inst.C)
 	}
 
-	return 0 <= *s.IP && *s.IP < len(prog)
+	return s.Running(prog)
+}
+
+// Running reports if s is not halted and
+// its instruction pointer is within prog.
+func (s *State) Running(prog []Instruction) bool {
+	return !s.Halted && 0 <= *s.IP && *s.IP < len(prog)
+}
+
+// Reg returns the value of register n,
+// or 0 if n is not a valid register.
+func (s *State) Reg(n int) int {
+	return s.reg(n)
+}
+
+// SetReg sets register n to v if n is a valid register.
+func (s *State) SetReg(n, v int) {
+	if p := s.preg(n); p != nil {
+		*p = v
+	}
 }
 
 func (s State) reg(n int) int {
@@ -164,6 +222,25 @@ type Operator interface {
 	Args() (a, b ArgKind)
 }
 
+// Writes reports if op writes register c. Operators do,
+// unless they implement Writes() bool returning false,
+// such as those of NewVoidOperator.
+func Writes(op Operator) bool {
+	if w, ok := op.(interface{ Writes() bool }); ok {
+		return w.Writes()
+	}
+	return true
+}
+
+// Halts reports if op always halts the device.
+// Operators do so only if they implement Halts() bool returning true.
+func Halts(op Operator) bool {
+	if h, ok := op.(interface{ Halts() bool }); ok {
+		return h.Halts()
+	}
+	return false
+}
+
 type ArgKind int
 
 const (
@@ -228,20 +305,18 @@ func (o opReg) Run(s *State, a, b, c int) {
 	}
 }
 
+// Op returns the operator of Standard with the specified name, or nil.
 func Op(name string) Operator {
-	return opmap[name]
+	return Standard.Op(name)
 }
 
+// Ops returns the operators of Standard.
 func Ops() []Operator {
-	return ops
+	return Standard.Ops()
 }
 
-var (
-	ops   []Operator
-	opmap map[string]Operator
-)
-
 func init() {
+	var ops []Operator
 
 	add := func(prefix string, f func(a, b int) int) {
 		ops = append(ops,
@@ -344,10 +419,8 @@ func init() {
 		return 0
 	})
 
-	opmap = make(map[string]Operator)
-	for _, o := range ops {
-		opmap[o.Name()] = o
-	}
+	Standard = mustInstructionSet(ops...)
+	Extended = Standard.mustWith(extendedOps()...)
 }
 
 type Instruction struct {
@@ -357,30 +430,52 @@ type Instruction struct {
 }
 
 func ParseInstruction(s string) (Instruction, error) {
-	var name string
-	var a, b, c int
-	_, err := fmt.Sscanf(s, "%s %d %d %d", &name, &a, &b, &c)
-	if err != nil {
+	return Standard.ParseInstruction(s)
+}
+
+// ParseInstruction parses an instruction using the operators of set.
+func (set *InstructionSet) ParseInstruction(s string) (Instruction, error) {
+	f := strings.Fields(s)
+	if len(f) == 0 {
 		return Instruction{}, errors.Errorf("ParseInstruction: can't parse %q", s)
 	}
 
-	op := Op(name)
+	op := set.Op(f[0])
 	if op == nil {
-		return Instruction{}, errors.Errorf("ParseInstruction: op %q unknown", name)
+		return Instruction{}, errors.Errorf("ParseInstruction: op %q unknown", f[0])
+	}
+
+	// operands not used by op may be omitted
+	args := f[1:]
+	if len(args) != operands(op) && len(args) != instArgs {
+		return Instruction{}, errors.Errorf("ParseInstruction: can't parse %q", s)
+	}
+	var v [instArgs]int
+	for i, a := range args {
+		n, err := strconv.Atoi(a)
+		if err != nil {
+			return Instruction{}, errors.Errorf("ParseInstruction: can't parse %q", s)
+		}
+		v[i] = n
 	}
 
 	return Instruction{
 		Op: op,
-		A:  a,
-		B:  b,
-		C:  c,
+		A:  v[0],
+		B:  v[1],
+		C:  v[2],
 	}, nil
 }
 
 func ParseProgram(v []string) ([]Instruction, error) {
+	return Standard.ParseProgram(v)
+}
+
+// ParseProgram parses instructions using the operators of set.
+func (set *InstructionSet) ParseProgram(v []string) ([]Instruction, error) {
 	var prog []Instruction
 	for no, line := range v {
-		inst, err := ParseInstruction(line)
+		inst, err := set.ParseInstruction(line)
 		if err != nil {
 			return prog, errors.Wrapf(err, "line %d", no+1)
 		}
@@ -390,7 +485,12 @@ func ParseProgram(v []string) ([]Instruction, error) {
 }
 
 func (i Instruction) String() string {
-	return fmt.Sprintf("%s %d %d %d", i.Op.Name(), i.A, i.B, i.C)
+	var buf bytes.Buffer
+	buf.WriteString(i.Op.Name())
+	for _, v := range []int{i.A, i.B, i.C}[:operands(i.Op)] {
+		fmt.Fprintf(&buf, " %d", v)
+	}
+	return buf.String()
 }
 
 func (i Instruction) Fmt(regnames []string) string {
@@ -411,9 +511,10 @@ func (i Instruction) Fmt(regnames []string) string {
 		buf.WriteString(s)
 	}
 
-	s = argName(regnames, ArgReg, i.C)
-	buf.WriteString(" ")
-	buf.WriteString(s)
+	if Writes(i.Op) {
+		buf.WriteString(" ")
+		buf.WriteString(argName(regnames, ArgReg, i.C))
+	}
 
 	return buf.String()
 }